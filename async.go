@@ -0,0 +1,87 @@
+package logs
+
+import (
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// AsyncWriter moves writes off the caller's goroutine: Write copies p onto
+// a bounded queue and a background goroutine writes it to the destination.
+// When the queue is full the event is dropped, so slow network sinks or
+// disks never block hot paths:
+//
+//	aw := logs.NewAsyncWriter(httpSink, 4096)
+//	logs.Configure(logs.Config{Writer: aw, Bypass: true})
+//	defer aw.Close()
+//
+// A dropped Write returns 0 and no error; writers that check short writes
+// (e.g. MultiLevelWriter) report io.ErrShortWrite for it. Errors from the
+// destination are discarded.
+type AsyncWriter struct {
+	w       io.Writer
+	queue   chan []byte
+	done    chan struct{}
+	dropped atomic.Int64
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewAsyncWriter starts an AsyncWriter forwarding to w with room for
+// queueSize pending writes. A queueSize below 1 is treated as 1.
+func NewAsyncWriter(w io.Writer, queueSize int) *AsyncWriter {
+	a := &AsyncWriter{
+		w:     w,
+		queue: make(chan []byte, max(queueSize, 1)),
+		done:  make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+func (a *AsyncWriter) run() {
+	defer close(a.done)
+	for p := range a.queue {
+		a.w.Write(p)
+	}
+}
+
+// Write queues a copy of p. It returns len(p) when queued, 0 when the queue
+// was full and the write was dropped, and os.ErrClosed after Close.
+func (a *AsyncWriter) Write(p []byte) (int, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.closed {
+		return 0, os.ErrClosed
+	}
+	select {
+	case a.queue <- append([]byte(nil), p...):
+		return len(p), nil
+	default:
+		a.dropped.Add(1)
+		metricsDropped.Add(1)
+		return 0, nil
+	}
+}
+
+// DroppedCount returns how many writes were dropped because the queue was
+// full. Drops are also counted in LogMetrics().EventsDropped.
+func (a *AsyncWriter) DroppedCount() int64 {
+	return a.dropped.Load()
+}
+
+// Close stops accepting writes and returns once every queued write has
+// reached the destination. Closing twice is a no-op. It does not close the
+// destination.
+func (a *AsyncWriter) Close() error {
+	a.mu.Lock()
+	if !a.closed {
+		a.closed = true
+		close(a.queue)
+	}
+	a.mu.Unlock()
+	<-a.done
+	return nil
+}
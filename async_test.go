@@ -0,0 +1,107 @@
+package logs
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingWriter blocks every Write until release is closed.
+type blockingWriter struct {
+	release chan struct{}
+	mu      sync.Mutex
+	buf     bytes.Buffer
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+// TestAsyncWriterDropsWhenFullAndDrainsOnClose verifies drop semantics and Close draining.
+func TestAsyncWriterDropsWhenFullAndDrainsOnClose(t *testing.T) {
+	dst := &blockingWriter{release: make(chan struct{})}
+	aw := NewAsyncWriter(dst, 2)
+
+	// The first write may already be taken by the background goroutine,
+	// so up to three fit before the queue is full.
+	accepted := 0
+	for i := 0; i < 10; i++ {
+		if n, err := aw.Write([]byte{'a' + byte(i), '\n'}); err != nil {
+			t.Fatalf("write %d: %v", i, err)
+		} else if n == 2 {
+			accepted++
+		}
+	}
+	if accepted < 2 || accepted > 3 {
+		t.Fatalf("expected 2-3 accepted writes, got %d", accepted)
+	}
+	if got := aw.DroppedCount(); got != int64(10-accepted) {
+		t.Fatalf("expected %d dropped, got %d", 10-accepted, got)
+	}
+
+	close(dst.release)
+	aw.Close()
+	if got := dst.buf.Len(); got != 2*accepted {
+		t.Fatalf("expected %d bytes drained, got %d", 2*accepted, got)
+	}
+	if _, err := aw.Write([]byte("late\n")); err != os.ErrClosed {
+		t.Fatalf("expected os.ErrClosed after Close, got %v", err)
+	}
+	aw.Close()
+}
+
+// TestAsyncWriterCopiesEventBuffers verifies queued events survive zerolog buffer reuse.
+func TestAsyncWriterCopiesEventBuffers(t *testing.T) {
+	var out syncBuffer
+	aw := NewAsyncWriter(&out, 100)
+	Configure(Config{Writer: aw, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	for i := 0; i < 20; i++ {
+		Zerolog().Info().Int("i", i).Msg("tick")
+	}
+	aw.Close()
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 20 {
+		t.Fatalf("expected 20 lines, got %d", len(lines))
+	}
+	for i, line := range lines {
+		if line["i"] != float64(i) {
+			t.Fatalf("line %d corrupted: %v", i, line)
+		}
+	}
+}
+
+// slowWriter simulates a slow sink.
+type slowWriter struct{}
+
+func (slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(time.Millisecond)
+	return len(p), nil
+}
+
+// BenchmarkAsyncWriterHotPath measures Write latency in front of a slow sink.
+func BenchmarkAsyncWriterHotPath(b *testing.B) {
+	aw := NewAsyncWriter(slowWriter{}, 1024)
+	b.Cleanup(func() { aw.Close() })
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		aw.Write(benchLine)
+	}
+}
+
+// BenchmarkSyncSlowWriter is the blocking baseline for BenchmarkAsyncWriterHotPath.
+func BenchmarkSyncSlowWriter(b *testing.B) {
+	var w io.Writer = slowWriter{}
+	for i := 0; i < b.N; i++ {
+		w.Write(benchLine)
+	}
+}
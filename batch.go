@@ -0,0 +1,167 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+)
+
+// batchMu serializes batch flushes so concurrent batches never interleave.
+var batchMu sync.Mutex
+
+// batchSlots recycles the buffered loggers behind BatchEvent. A slot is
+// rebuilt only when Configure has replaced the logger it was built for.
+var batchSlots sync.Pool
+
+// ErrBatchEvent is returned by Batch for events not created with BatchEvent.
+var ErrBatchEvent = errors.New("smplog: batch event was not created with BatchEvent")
+
+// EventBatch buffers formatted log events and writes them to the configured
+// writer in a single Write call on Flush.
+//
+//	b := logs.NewBatch()
+//	for _, item := range items {
+//		b.Add(logs.InfoLevel, item.Result())
+//	}
+//	err := b.Flush()
+type EventBatch struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	out    io.Writer
+	logger Logger
+}
+
+// NewBatch returns an empty batch that formats events per the active Config
+// and flushes them to its Writer.
+func NewBatch() *EventBatch {
+	cfg := Configured()
+	b := &EventBatch{out: cfg.Writer}
+	b.logger = bufferedLogger(cfg, lockedWriter{mu: &b.mu, w: &b.buf})
+	return b
+}
+
+// Add buffers msg at level. Events below the configured level are dropped.
+func (b *EventBatch) Add(level Level, msg string) *EventBatch {
+	b.logger.WithLevel(level).Msg(msg)
+	return b
+}
+
+// Event returns an event at level that is buffered into b when sent, for
+// attaching fields before Msg.
+func (b *EventBatch) Event(level Level) *Event {
+	return b.logger.WithLevel(level)
+}
+
+// Flush writes every buffered event in one Write call and empties the batch.
+func (b *EventBatch) Flush() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.buf.Len() == 0 {
+		return nil
+	}
+	batchMu.Lock()
+	_, err := b.out.Write(b.buf.Bytes())
+	batchMu.Unlock()
+	b.buf.Reset()
+	return err
+}
+
+// BatchedEvent is an unsent event created by BatchEvent. It owns the buffer
+// its event is encoded into until Batch sends it; use the embedded Event to
+// add fields.
+type BatchedEvent struct {
+	*Event
+	slot *batchSlot
+}
+
+// batchSlot is a logger built from the active config whose output lands in
+// buf. base identifies the Configure call it was built for.
+type batchSlot struct {
+	base   *Logger
+	buf    bytes.Buffer
+	logger Logger
+}
+
+// BatchEvent returns an unsent event at level for later submission with
+// Batch. Sending the embedded Event any other way (Msg, Send) writes
+// nothing. Events below the configured level have a nil Event and are
+// skipped by Batch.
+func BatchEvent(level Level) *BatchedEvent {
+	stateMu.RLock()
+	cfg, base := currentConfig, currentLogger
+	stateMu.RUnlock()
+
+	slot, _ := batchSlots.Get().(*batchSlot)
+	if slot == nil || slot.base != base {
+		slot = &batchSlot{base: base}
+		slot.logger = bufferedLogger(cfg, &slot.buf)
+	}
+	e := slot.logger.WithLevel(level)
+	if e == nil {
+		batchSlots.Put(slot)
+		return &BatchedEvent{}
+	}
+	return &BatchedEvent{Event: e, slot: slot}
+}
+
+// Batch sends events created with BatchEvent and writes them to the
+// configured writer in a single Write call. Nil events (filtered by level)
+// are skipped, and each event can be batched once. Events not created with
+// BatchEvent are discarded and ErrBatchEvent is returned without writing
+// anything.
+func Batch(events []*BatchedEvent) error {
+	var err error
+	for _, be := range events {
+		if be != nil && be.Event != nil && be.slot == nil {
+			err = ErrBatchEvent
+		}
+	}
+
+	var out bytes.Buffer
+	for _, be := range events {
+		if be == nil || be.Event == nil {
+			continue
+		}
+		if be.slot == nil {
+			be.Discard()
+			continue
+		}
+		if err != nil {
+			be.Discard()
+		} else {
+			be.Send()
+			out.Write(be.slot.buf.Bytes())
+		}
+		be.slot.buf.Reset()
+		batchSlots.Put(be.slot)
+		be.Event, be.slot = nil, nil
+	}
+	if err != nil || out.Len() == 0 {
+		return err
+	}
+	batchMu.Lock()
+	defer batchMu.Unlock()
+	_, err = Configured().Writer.Write(out.Bytes())
+	return err
+}
+
+// bufferedLogger builds a logger from cfg whose output lands in w.
+// ConfigureZerolog is skipped since it already ran when cfg was applied.
+func bufferedLogger(cfg Config, w io.Writer) Logger {
+	cfg.Writer = w
+	cfg.ConfigureZerolog = nil
+	return buildLogger(cfg)
+}
+
+// lockedWriter serializes writes to w with mu.
+type lockedWriter struct {
+	mu *sync.Mutex
+	w  io.Writer
+}
+
+func (w lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
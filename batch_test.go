@@ -0,0 +1,128 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingWriter records each Write call separately.
+type recordingWriter struct {
+	mu     sync.Mutex
+	writes []string
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, string(p))
+	return len(p), nil
+}
+
+// TestEventBatchFlushWritesOnce verifies concurrent batches flush in single, non-interleaved writes.
+func TestEventBatchFlushWritesOnce(t *testing.T) {
+	var out recordingWriter
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			b := NewBatch()
+			for j := 0; j < 10; j++ {
+				b.Add(InfoLevel, fmt.Sprintf("batch-%d", id))
+			}
+			b.Add(DebugLevel, "filtered")
+			if err := b.Flush(); err != nil {
+				t.Errorf("flush: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(out.writes) != 4 {
+		t.Fatalf("expected 4 writes, got %d", len(out.writes))
+	}
+	for _, w := range out.writes {
+		lines := strings.Split(strings.TrimSpace(w), "\n")
+		if len(lines) != 10 {
+			t.Fatalf("expected 10 lines per write, got %d: %q", len(lines), w)
+		}
+		first := decodeLines(t, lines[0])[0]["message"]
+		for _, line := range lines {
+			if msg := decodeLines(t, line)[0]["message"]; msg != first {
+				t.Fatalf("interleaved batch output: %q", w)
+			}
+		}
+	}
+}
+
+// TestBatchSendsPrebuiltEvents verifies Batch writes BatchEvent events in one call.
+func TestBatchSendsPrebuiltEvents(t *testing.T) {
+	var out recordingWriter
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	a, b := BatchEvent(InfoLevel), BatchEvent(WarnLevel)
+	a.Str("item", "a")
+	b.Str("item", "b")
+	events := []*BatchedEvent{a, BatchEvent(DebugLevel), b}
+	if err := Batch(events); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(out.writes) != 1 {
+		t.Fatalf("expected 1 write, got %d", len(out.writes))
+	}
+	lines := decodeLines(t, out.writes[0])
+	if len(lines) != 2 || lines[0]["item"] != "a" || lines[1]["level"] != "warn" {
+		t.Fatalf("unexpected batch output: %q", out.writes[0])
+	}
+
+	var buf bytes.Buffer
+	foreignLogger := Zerolog().Output(&buf)
+	foreign := &BatchedEvent{Event: foreignLogger.Info()}
+	if err := Batch([]*BatchedEvent{foreign}); !errors.Is(err, ErrBatchEvent) {
+		t.Fatalf("expected ErrBatchEvent, got %v", err)
+	}
+	if buf.Len() != 0 || len(out.writes) != 1 {
+		t.Fatal("expected foreign event to be discarded without writing")
+	}
+}
+
+// TestBatchEventSentDirectlyLeavesNoState verifies an event finished with Msg writes nothing and does not leak into a later Batch.
+func TestBatchEventSentDirectlyLeavesNoState(t *testing.T) {
+	var out recordingWriter
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	for i := 0; i < 100; i++ {
+		BatchEvent(InfoLevel).Msg("stray")
+	}
+	if len(out.writes) != 0 {
+		t.Fatalf("expected direct sends to write nothing, got %q", out.writes)
+	}
+
+	e := BatchEvent(InfoLevel)
+	e.Str("item", "kept")
+	if err := Batch([]*BatchedEvent{e}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := Batch([]*BatchedEvent{e}); err != nil {
+		t.Fatalf("expected a batched event to be skipped on reuse, got %v", err)
+	}
+	if len(out.writes) != 1 {
+		t.Fatalf("expected 1 write, got %d", len(out.writes))
+	}
+	lines := decodeLines(t, out.writes[0])
+	if len(lines) != 1 || lines[0]["item"] != "kept" {
+		t.Fatalf("unexpected batch output: %q", out.writes[0])
+	}
+}
@@ -0,0 +1,58 @@
+package logs
+
+import (
+	"io"
+	"testing"
+)
+
+// NopWriter returns a writer that discards everything, for benchmarks that
+// should measure logging cost without I/O.
+func NopWriter() io.Writer {
+	return io.Discard
+}
+
+// BenchmarkLogger configures cfg, then logs msg at info level with fields
+// b.N times. A nil cfg.Writer uses NopWriter. The previous configuration is
+// restored when the benchmark ends.
+//
+//	func BenchmarkAPI(b *testing.B) {
+//		logs.BenchmarkLogger(b, logs.Config{Bypass: true}, "request", map[string]any{"status": 200})
+//	}
+func BenchmarkLogger(b *testing.B, cfg Config, msg string, fields map[string]any) {
+	b.Helper()
+	if cfg.Writer == nil {
+		cfg.Writer = NopWriter()
+	}
+	prev := Configured()
+	Configure(cfg)
+	b.Cleanup(func() { Configure(prev) })
+
+	l := *Zerolog()
+	if len(fields) > 0 {
+		l = WithFields(fields)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Info().Msg(msg)
+	}
+}
+
+// BenchmarkConsoleLogger runs BenchmarkLogger in console mode with the
+// default formatting, writing to NopWriter.
+func BenchmarkConsoleLogger(b *testing.B, msg string, fields map[string]any) {
+	b.Helper()
+	cfg := DefaultConfig()
+	cfg.Writer = NopWriter()
+	BenchmarkLogger(b, cfg, msg, fields)
+}
+
+// BenchmarkBypassLogger runs BenchmarkLogger in bypass (raw JSON) mode,
+// writing to NopWriter.
+func BenchmarkBypassLogger(b *testing.B, msg string, fields map[string]any) {
+	b.Helper()
+	cfg := DefaultConfig()
+	cfg.Writer = NopWriter()
+	cfg.Bypass = true
+	BenchmarkLogger(b, cfg, msg, fields)
+}
@@ -0,0 +1,85 @@
+package logs
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+var benchFields = map[string]any{"method": "GET", "status": 200, "path": "/api/v1/items"}
+
+// TestMain runs the logger benchmarks before the tests when RUN_BENCHMARKS
+// is set, so a plain `go test` run reports comparable throughput numbers.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if os.Getenv("RUN_BENCHMARKS") != "" {
+		for _, bench := range []struct {
+			name string
+			fn   func(*testing.B)
+		}{
+			{"smplog/console", BenchmarkSmplogConsole},
+			{"smplog/bypass", BenchmarkSmplogBypass},
+			{"zerolog", BenchmarkRawZerolog},
+			{"slog", BenchmarkSlogJSON},
+		} {
+			r := testing.Benchmark(bench.fn)
+			fmt.Printf("%-16s %s\t%s\n", bench.name, r, r.MemString())
+		}
+	}
+	os.Exit(m.Run())
+}
+
+// BenchmarkSmplogConsole measures console-mode logging with fields.
+func BenchmarkSmplogConsole(b *testing.B) {
+	BenchmarkConsoleLogger(b, "request handled", benchFields)
+}
+
+// BenchmarkSmplogBypass measures bypass-mode (JSON) logging with fields.
+func BenchmarkSmplogBypass(b *testing.B) {
+	BenchmarkBypassLogger(b, "request handled", benchFields)
+}
+
+// BenchmarkRawZerolog is the zerolog baseline for BenchmarkSmplogBypass.
+func BenchmarkRawZerolog(b *testing.B) {
+	l := zerolog.New(NopWriter()).With().Timestamp().Fields(benchFields).Logger()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Info().Msg("request handled")
+	}
+}
+
+// BenchmarkSlogJSON is the log/slog baseline for BenchmarkSmplogBypass.
+func BenchmarkSlogJSON(b *testing.B) {
+	l := slog.New(slog.NewJSONHandler(NopWriter(), nil)).With("method", "GET", "status", 200, "path", "/api/v1/items")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Info("request handled")
+	}
+}
+
+// TestBenchmarkLoggerRestoresConfig verifies the helper restores the previous configuration.
+func TestBenchmarkLoggerRestoresConfig(t *testing.T) {
+	Configure(Config{Writer: NopWriter(), Level: WarnLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	benchtime := flag.Lookup("test.benchtime")
+	prev := benchtime.Value.String()
+	benchtime.Value.Set("10x")
+	defer benchtime.Value.Set(prev)
+
+	r := testing.Benchmark(func(b *testing.B) {
+		BenchmarkConsoleLogger(b, "msg", nil)
+	})
+	if r.N == 0 {
+		t.Fatal("expected the benchmark to run")
+	}
+	if cfg := Configured(); !cfg.Bypass || cfg.Level != WarnLevel {
+		t.Fatalf("expected previous config restored, got bypass=%v level=%v", cfg.Bypass, cfg.Level)
+	}
+}
@@ -0,0 +1,32 @@
+package logs
+
+import "github.com/rs/zerolog"
+
+// CallerSkip returns a child of the active logger that reports the caller
+// skip frames above the call site, for libraries that wrap smplog. skip 0
+// keeps the current depth (the function calling Msg); skip 1 skips one more
+// frame, e.g. a one-level wrapper:
+//
+//	var wrapped = logs.CallerSkip(1)
+//
+//	func Info(msg string) { wrapped.Info().Msg(msg) } // caller: Info's caller
+//
+// The caller field is added even when Config.Caller is off; with it on, it
+// replaces the default caller rather than duplicating it. The logger is a
+// snapshot; call CallerSkip again after Configure.
+func CallerSkip(skip int) Logger {
+	cfg := Configured()
+	base := *Zerolog()
+	if cfg.Caller {
+		cfg.Caller = false
+		cfg.ConfigureZerolog = nil
+		base = buildLogger(cfg)
+	}
+	return WithCallerSkip(base, skip)
+}
+
+// WithCallerSkip is CallerSkip for an arbitrary logger, with the same skip
+// convention. l should not already add a caller field.
+func WithCallerSkip(l Logger, skip int) Logger {
+	return l.With().CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + skip).Logger()
+}
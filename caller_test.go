@@ -0,0 +1,49 @@
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func wrappedInfo(l Logger, msg string) { l.Info().Msg(msg) }
+
+// TestCallerSkipReportsWrapperCaller verifies skip 1 attributes the event to the wrapper's caller.
+func TestCallerSkipReportsWrapperCaller(t *testing.T) {
+	for _, caller := range []bool{false, true} {
+		var out bytes.Buffer
+		Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, Caller: caller})
+		t.Cleanup(func() { Configure(DefaultConfig()) })
+
+		wrappedInfo(CallerSkip(1), "skipped")
+		direct := CallerSkip(0)
+		direct.Info().Msg("direct")
+
+		lines := decodeLines(t, out.String())
+		if len(lines) != 2 {
+			t.Fatalf("caller=%v: expected 2 lines, got %d", caller, len(lines))
+		}
+		for _, line := range lines {
+			got, _ := line["caller"].(string)
+			if !strings.Contains(got, "caller_test.go:") {
+				t.Fatalf("caller=%v: expected caller in caller_test.go, got %q", caller, got)
+			}
+		}
+		if strings.Count(out.String(), `"caller"`) != 2 {
+			t.Fatalf("caller=%v: expected one caller field per line:\n%s", caller, out.String())
+		}
+		if lines[0]["caller"] == lines[1]["caller"] {
+			t.Fatalf("caller=%v: expected distinct call sites", caller)
+		}
+	}
+}
+
+// TestWithCallerSkipOnArbitraryLogger verifies WithCallerSkip applies to any logger.
+func TestWithCallerSkipOnArbitraryLogger(t *testing.T) {
+	var out bytes.Buffer
+	wrappedInfo(WithCallerSkip(New(&out), 1), "custom")
+	lines := decodeLines(t, out.String())
+	if got, _ := lines[0]["caller"].(string); !strings.Contains(got, "caller_test.go:") {
+		t.Fatalf("expected caller in caller_test.go, got %q", got)
+	}
+}
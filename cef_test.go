@@ -0,0 +1,33 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestCEFWriterFormatsErrorEvent verifies the CEF header, severity and extensions.
+func TestCEFWriterFormatsErrorEvent(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: NewCEFWriter(&out, "Acme", "Gate|way", "1.0"), Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Error().Str("ip", "10.0.0.1").Int("attempts", 3).Msg("login failed")
+
+	want := `CEF:0|Acme|Gate\|way|1.0|error|login failed|7|ip=10.0.0.1 attempts=3` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected line:\n got %q\nwant %q", out.String(), want)
+	}
+}
+
+// TestCEFWriterEscapesValues verifies header and extension escaping and passthrough.
+func TestCEFWriterEscapesValues(t *testing.T) {
+	var out bytes.Buffer
+	w := NewCEFWriter(&out, "v", "p", "1")
+	w.Write([]byte(`{"level":"fatal","message":"a|b\\c","query":"x=1\ny"}`))
+	w.Write([]byte("plain\n"))
+
+	want := `CEF:0|v|p|1|fatal|a\|b\\c|9|query=x\=1\ny` + "\nplain\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
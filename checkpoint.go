@@ -0,0 +1,62 @@
+package logs
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Checkpointer accumulates fields across the steps of an operation and logs
+// them as a single info event at each checkpoint.
+//
+//	cp := logs.NewCheckpointer()
+//	cp.Set("rows", n).Set("stage", "load")
+//	cp.CheckpointDiff("loaded")
+type Checkpointer struct {
+	mu      sync.Mutex
+	pending map[string]any // fields set since the last checkpoint
+	last    map[string]any // every field as of the last checkpoint
+}
+
+// NewCheckpointer returns an empty Checkpointer.
+func NewCheckpointer() *Checkpointer {
+	return &Checkpointer{
+		pending: make(map[string]any),
+		last:    make(map[string]any),
+	}
+}
+
+// Set records value under key for the next checkpoint.
+func (c *Checkpointer) Set(key string, value any) *Checkpointer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[key] = value
+	return c
+}
+
+// Checkpoint logs msg with every field set since the last checkpoint and
+// resets the accumulator.
+func (c *Checkpointer) Checkpoint(msg string) {
+	c.emit(msg, false)
+}
+
+// CheckpointDiff is Checkpoint restricted to fields whose value differs
+// (by reflect.DeepEqual) from the previous checkpoint, or that are new.
+func (c *Checkpointer) CheckpointDiff(msg string) {
+	c.emit(msg, true)
+}
+
+func (c *Checkpointer) emit(msg string, diffOnly bool) {
+	c.mu.Lock()
+	fields := make(map[string]any, len(c.pending))
+	for k, v := range c.pending {
+		prev, seen := c.last[k]
+		if !diffOnly || !seen || !reflect.DeepEqual(prev, v) {
+			fields[k] = v
+		}
+		c.last[k] = v
+	}
+	c.pending = make(map[string]any)
+	c.mu.Unlock()
+
+	Zerolog().Info().Fields(fields).Msg(msg)
+}
@@ -0,0 +1,51 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestCheckpointDiffLogsOnlyChangedFields verifies each diff checkpoint carries only changed fields.
+func TestCheckpointDiffLogsOnlyChangedFields(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	cp := NewCheckpointer()
+	cp.Set("stage", "load").Set("rows", 10).Set("tags", []string{"a"})
+	cp.CheckpointDiff("first")
+	cp.Set("stage", "load").Set("rows", 20).Set("tags", []string{"a"})
+	cp.CheckpointDiff("second")
+	cp.Set("stage", "done").Set("rows", 20).Set("errors", 0)
+	cp.CheckpointDiff("third")
+
+	want := `{"level":"info","rows":10,"stage":"load","tags":["a"],"message":"first"}` + "\n" +
+		`{"level":"info","rows":20,"message":"second"}` + "\n" +
+		`{"level":"info","errors":0,"stage":"done","message":"third"}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
+
+// TestCheckpointLogsAllPendingFields verifies Checkpoint emits every pending field and resets.
+func TestCheckpointLogsAllPendingFields(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	cp := NewCheckpointer()
+	cp.Set("rows", 1)
+	cp.Checkpoint("one")
+	cp.Set("rows", 1)
+	cp.Checkpoint("two")
+	cp.Checkpoint("empty")
+
+	want := `{"level":"info","rows":1,"message":"one"}` + "\n" +
+		`{"level":"info","rows":1,"message":"two"}` + "\n" +
+		`{"level":"info","message":"empty"}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
@@ -0,0 +1,54 @@
+package logs
+
+import "strings"
+
+// CircularWriter is a goroutine-safe io.Writer that keeps the most recent
+// size bytes of output in memory, for embedded systems and tests where disk
+// writes are unwanted:
+//
+//	cw := logs.NewCircularWriter(32 << 10)
+//	logs.Configure(logs.Config{Writer: cw, Bypass: true})
+//	lines := cw.Lines()
+//
+// It uses one fixed allocation; once full, new writes overwrite the oldest
+// bytes. It shares its ring with ReplayBuffer.
+type CircularWriter struct {
+	ring *ReplayBuffer
+}
+
+// NewCircularWriter returns an empty CircularWriter holding up to size bytes.
+// It panics if size is not positive.
+func NewCircularWriter(size int) *CircularWriter {
+	ring, err := NewReplayBuffer(size)
+	if err != nil {
+		panic(err.Error())
+	}
+	return &CircularWriter{ring: ring}
+}
+
+// Write appends p, overwriting the oldest bytes once the buffer is full.
+func (c *CircularWriter) Write(p []byte) (int, error) {
+	return c.ring.Write(p)
+}
+
+// Read returns a copy of all stored bytes in write order. After the buffer
+// wraps, the first line may be missing its beginning.
+func (c *CircularWriter) Read() []byte {
+	out, _ := c.ring.raw()
+	return out
+}
+
+// Lines returns the stored lines in write order without trailing newlines.
+// A line whose beginning was overwritten is dropped.
+func (c *CircularWriter) Lines() []string {
+	data := strings.TrimSuffix(string(c.ring.contents()), "\n")
+	if data == "" {
+		return nil
+	}
+	return strings.Split(data, "\n")
+}
+
+// Reset discards the stored content.
+func (c *CircularWriter) Reset() {
+	c.ring.reset()
+}
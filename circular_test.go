@@ -0,0 +1,98 @@
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestCircularWriterWrapsAndReports verifies Read, Lines and Reset after the ring wraps.
+func TestCircularWriterWrapsAndReports(t *testing.T) {
+	cw := NewCircularWriter(16)
+	cw.Write([]byte("first\n"))
+	cw.Write([]byte("second\n"))
+	if got := string(cw.Read()); got != "first\nsecond\n" {
+		t.Fatalf("unexpected content %q", got)
+	}
+
+	cw.Write([]byte("third\n"))
+	if got := string(cw.Read()); got != "st\nsecond\nthird\n" {
+		t.Fatalf("unexpected wrapped content %q", got)
+	}
+	if got := cw.Lines(); strings.Join(got, ",") != "second,third" {
+		t.Fatalf("expected partial line dropped, got %q", got)
+	}
+
+	cw.Reset()
+	if len(cw.Read()) != 0 || cw.Lines() != nil {
+		t.Fatal("expected empty writer after Reset")
+	}
+	cw.Write([]byte("again\n"))
+	if got := cw.Lines(); len(got) != 1 || got[0] != "again" {
+		t.Fatalf("unexpected lines after Reset %q", got)
+	}
+}
+
+// TestCircularWriterConcurrentLogging verifies whole lines survive concurrent writers.
+func TestCircularWriterConcurrentLogging(t *testing.T) {
+	cw := NewCircularWriter(1 << 16)
+	Configure(Config{Writer: cw, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				Zerolog().Info().Int("i", i).Msg("tick")
+			}
+		}()
+	}
+	wg.Wait()
+
+	lines := decodeLines(t, string(cw.Read()))
+	if len(lines) != 400 {
+		t.Fatalf("expected 400 lines, got %d", len(lines))
+	}
+}
+
+// TestNewCircularWriterPanicsOnBadSize verifies non-positive sizes are rejected.
+func TestNewCircularWriterPanicsOnBadSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	NewCircularWriter(0)
+}
+
+var benchLine = []byte(`{"level":"info","message":"tick","i":42}` + "\n")
+
+// BenchmarkCircularWriterConcurrent measures concurrent writes into the fixed ring.
+func BenchmarkCircularWriterConcurrent(b *testing.B) {
+	cw := NewCircularWriter(64 << 10)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			cw.Write(benchLine)
+		}
+	})
+}
+
+// BenchmarkBytesBufferConcurrent measures the same writes into a mutex-guarded bytes.Buffer.
+func BenchmarkBytesBufferConcurrent(b *testing.B) {
+	var (
+		mu  sync.Mutex
+		buf bytes.Buffer
+	)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			buf.Write(benchLine)
+			mu.Unlock()
+		}
+	})
+}
@@ -0,0 +1,168 @@
+// Package cloudwatch provides an smplog writer that batches log lines into
+// CloudWatch Logs PutLogEvents calls. It depends only on the standard
+// library; callers adapt their AWS SDK client to the Client interface.
+package cloudwatch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	logs "github.com/danmuck/smplog"
+)
+
+// CloudWatch Logs PutLogEvents limits. Each event counts its message size
+// plus a fixed overhead toward the batch byte limit.
+const (
+	maxBatchEvents  = 10000
+	maxBatchBytes   = 1048576
+	eventOverhead   = 26
+	defaultInterval = 5 * time.Second
+	sendTimeout     = 10 * time.Second
+)
+
+// InputLogEvent is one log event of a PutLogEvents batch. Timestamp is in
+// milliseconds since the Unix epoch.
+type InputLogEvent struct {
+	Message   string
+	Timestamp int64
+}
+
+// PutLogEventsInput is the batch passed to Client.PutLogEvents.
+type PutLogEventsInput struct {
+	LogGroupName  string
+	LogStreamName string
+	LogEvents     []InputLogEvent
+}
+
+// PutLogEventsOutput is the result of Client.PutLogEvents. NewWriter does
+// not inspect it.
+type PutLogEventsOutput struct{}
+
+// Client sends one PutLogEvents batch. Adapt *cloudwatchlogs.Client from
+// aws-sdk-go-v2 by copying the input into a cloudwatchlogs.PutLogEventsInput;
+// tests can substitute a fake.
+type Client interface {
+	PutLogEvents(ctx context.Context, in *PutLogEventsInput) (*PutLogEventsOutput, error)
+}
+
+// NewWriter returns a writer that batches log lines into PutLogEvents calls
+// for logGroup/logStream. A batch is sent when the next line would exceed
+// CloudWatch's 10,000-event or 1MB limit, every 5 seconds, and on Close.
+// Batches are sent outside the writer's lock with a 10 second timeout, so a
+// slow endpoint only delays the Write that filled the batch. Failed calls
+// are reported on stderr and the batch dropped.
+//
+//	w := cloudwatch.NewWriter("app", "web-1", sdkAdapter{cloudwatchlogs.NewFromConfig(awsCfg)})
+//	logs.Configure(logs.Config{Writer: w, Bypass: true})
+//	defer w.Close()
+func NewWriter(logGroup, logStream string, client Client) io.WriteCloser {
+	w := &writer{
+		group:  logGroup,
+		stream: logStream,
+		client: client,
+		done:   make(chan struct{}),
+	}
+	w.wg.Add(1)
+	go w.run(defaultInterval)
+	return w
+}
+
+type writer struct {
+	group  string
+	stream string
+	client Client
+
+	mu      sync.Mutex // guards pending, size and closed; never held while sending
+	pending []InputLogEvent
+	size    int
+	closed  bool
+
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	msg := string(bytes.TrimRight(p, "\n"))
+	if msg == "" {
+		return len(p), nil
+	}
+	ts := time.Now().UnixMilli()
+	eventSize := len(msg) + eventOverhead
+
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return 0, logs.ErrSinkClosed
+	}
+	var full []InputLogEvent
+	if len(w.pending) >= maxBatchEvents || w.size+eventSize > maxBatchBytes {
+		full = w.takeLocked()
+	}
+	w.pending = append(w.pending, InputLogEvent{Message: msg, Timestamp: ts})
+	w.size += eventSize
+	w.mu.Unlock()
+
+	w.send(full)
+	return len(p), nil
+}
+
+// Close sends the pending batch and stops the background flusher.
+func (w *writer) Close() error {
+	w.closeOnce.Do(func() {
+		close(w.done)
+		w.wg.Wait()
+		w.mu.Lock()
+		w.closed = true
+		batch := w.takeLocked()
+		w.mu.Unlock()
+		w.send(batch)
+	})
+	return nil
+}
+
+func (w *writer) run(interval time.Duration) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			w.mu.Lock()
+			batch := w.takeLocked()
+			w.mu.Unlock()
+			w.send(batch)
+		}
+	}
+}
+
+// takeLocked swaps out the pending batch. w.mu must be held.
+func (w *writer) takeLocked() []InputLogEvent {
+	batch := w.pending
+	w.pending, w.size = nil, 0
+	return batch
+}
+
+// send delivers batch with a bounded timeout. It must be called without w.mu.
+func (w *writer) send(batch []InputLogEvent) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	_, err := w.client.PutLogEvents(ctx, &PutLogEventsInput{
+		LogGroupName:  w.group,
+		LogStreamName: w.stream,
+		LogEvents:     batch,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "smplog: cloudwatch dropped %d events: %v\n", len(batch), err)
+	}
+}
@@ -0,0 +1,101 @@
+package cloudwatch
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClient records PutLogEvents batches.
+type fakeClient struct {
+	mu      sync.Mutex
+	batches []*PutLogEventsInput
+}
+
+func (f *fakeClient) PutLogEvents(_ context.Context, in *PutLogEventsInput) (*PutLogEventsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, in)
+	return &PutLogEventsOutput{}, nil
+}
+
+// blockingClient holds every PutLogEvents call until release is closed.
+type blockingClient struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingClient) PutLogEvents(ctx context.Context, _ *PutLogEventsInput) (*PutLogEventsOutput, error) {
+	b.started <- struct{}{}
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &PutLogEventsOutput{}, nil
+}
+
+// TestWriterRespectsBatchLimits verifies batches split at the byte limit and Close flushes.
+func TestWriterRespectsBatchLimits(t *testing.T) {
+	client := &fakeClient{}
+	w := NewWriter("group", "stream", client)
+
+	// Four ~400KB lines: at most two fit in one 1MB batch.
+	line := strings.Repeat("x", 400*1024) + "\n"
+	for i := 0; i < 4; i++ {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if len(client.batches) != 1 {
+		t.Fatalf("expected one full batch before Close, got %d", len(client.batches))
+	}
+	w.Close()
+
+	if len(client.batches) != 2 {
+		t.Fatalf("expected Close to flush the remaining batch, got %d batches", len(client.batches))
+	}
+	for _, b := range client.batches {
+		if b.LogGroupName != "group" || b.LogStreamName != "stream" || len(b.LogEvents) != 2 {
+			t.Fatalf("unexpected batch: group=%s stream=%s events=%d", b.LogGroupName, b.LogStreamName, len(b.LogEvents))
+		}
+		if strings.HasSuffix(b.LogEvents[0].Message, "\n") || b.LogEvents[0].Timestamp == 0 {
+			t.Fatal("expected trimmed message with timestamp")
+		}
+	}
+}
+
+// TestWriterSlowClientDoesNotBlockWrites verifies a send in flight does not hold up other writers.
+func TestWriterSlowClientDoesNotBlockWrites(t *testing.T) {
+	client := &blockingClient{started: make(chan struct{}, 2), release: make(chan struct{})}
+	w := NewWriter("group", "stream", client)
+	defer func() {
+		close(client.release)
+		w.Close()
+	}()
+
+	line := []byte(strings.Repeat("x", 600*1024) + "\n")
+	if _, err := w.Write(line); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	// The second large line fills the batch; its Write blocks in PutLogEvents.
+	go w.Write(line)
+	select {
+	case <-client.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a batch to be sent")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		w.Write([]byte("small\n"))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Write blocked behind an in-flight PutLogEvents call")
+	}
+}
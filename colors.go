@@ -3,6 +3,7 @@ package logs
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -50,9 +51,35 @@ var (
 	StyleStrike    = sgr(9)
 )
 
+// styleNames maps the names accepted by StyleFrom to style sequences:
+// text attributes, the 16 base colors as 256-color foregrounds, and
+// their bg_ background counterparts.
+var styleNames = func() map[string]string {
+	m := map[string]string{
+		"reset":     StyleReset,
+		"bold":      StyleBold,
+		"dim":       StyleDim,
+		"italic":    StyleItalic,
+		"underline": StyleUnderline,
+		"blink":     StyleBlink,
+		"reverse":   StyleReverse,
+		"hidden":    StyleHidden,
+		"strike":    StyleStrike,
+	}
+	base := []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+	for i, name := range base {
+		m[name] = StyleColor256(i)
+		m["bright_"+name] = StyleColor256(i + 8)
+		m["bg_"+name] = BgColor256(i)
+		m["bg_bright_"+name] = BgColor256(i + 8)
+	}
+	return m
+}()
+
 // ansiPattern matches CSI Select Graphic Rendition (SGR) sequences,
-// e.g. "\x1b[31m" (red) and "\x1b[0m" (reset).
-var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+// e.g. "\x1b[31m" (red) and "\x1b[0m" (reset), and OSC sequences such as
+// Hyperlink's "\x1b]8;;url\x1b\\", terminated by ST or BEL.
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m|\x1b\][^\x1b\x07]*(?:\x1b\\|\x07)`)
 
 // ConsoleColors defines ANSI colors applied by console output:
 // zerolog ConsoleWriter fields plus stdout menu/CLI print helpers.
@@ -105,6 +132,70 @@ func NoColors() ConsoleColors {
 	return ConsoleColors{}
 }
 
+// Merge returns a copy of c with every non-empty field of other applied,
+// so partial overrides keep the rest of the palette:
+//
+//	colors := logs.DefaultColors().Merge(logs.ConsoleColors{Error: logs.StyleColor256(196)})
+func (c ConsoleColors) Merge(other ConsoleColors) ConsoleColors {
+	c.Trace = firstNonEmpty(other.Trace, c.Trace)
+	c.Debug = firstNonEmpty(other.Debug, c.Debug)
+	c.Info = firstNonEmpty(other.Info, c.Info)
+	c.Warn = firstNonEmpty(other.Warn, c.Warn)
+	c.Error = firstNonEmpty(other.Error, c.Error)
+	c.Fatal = firstNonEmpty(other.Fatal, c.Fatal)
+	c.Panic = firstNonEmpty(other.Panic, c.Panic)
+	c.Message = firstNonEmpty(other.Message, c.Message)
+	c.Timestamp = firstNonEmpty(other.Timestamp, c.Timestamp)
+	c.FieldName = firstNonEmpty(other.FieldName, c.FieldName)
+	c.FieldValue = firstNonEmpty(other.FieldValue, c.FieldValue)
+	c.Menu = firstNonEmpty(other.Menu, c.Menu)
+	c.Title = firstNonEmpty(other.Title, c.Title)
+	c.Prompt = firstNonEmpty(other.Prompt, c.Prompt)
+	c.Data = firstNonEmpty(other.Data, c.Data)
+	c.Divider = firstNonEmpty(other.Divider, c.Divider)
+	return c
+}
+
+// WithLevel returns a copy of c with the color for level set to color.
+// Levels without a palette entry (NoLevel, Disabled) leave c unchanged.
+func (c ConsoleColors) WithLevel(level Level, color string) ConsoleColors {
+	switch level {
+	case TraceLevel:
+		c.Trace = color
+	case DebugLevel:
+		c.Debug = color
+	case InfoLevel:
+		c.Info = color
+	case WarnLevel:
+		c.Warn = color
+	case ErrorLevel:
+		c.Error = color
+	case FatalLevel:
+		c.Fatal = color
+	case PanicLevel:
+		c.Panic = color
+	}
+	return c
+}
+
+// WithTrace is WithLevel(TraceLevel, color).
+func (c ConsoleColors) WithTrace(color string) ConsoleColors { return c.WithLevel(TraceLevel, color) }
+
+// WithDebug is WithLevel(DebugLevel, color).
+func (c ConsoleColors) WithDebug(color string) ConsoleColors { return c.WithLevel(DebugLevel, color) }
+
+// WithInfo is WithLevel(InfoLevel, color).
+func (c ConsoleColors) WithInfo(color string) ConsoleColors { return c.WithLevel(InfoLevel, color) }
+
+// WithWarn is WithLevel(WarnLevel, color).
+func (c ConsoleColors) WithWarn(color string) ConsoleColors { return c.WithLevel(WarnLevel, color) }
+
+// WithError is WithLevel(ErrorLevel, color).
+func (c ConsoleColors) WithError(color string) ConsoleColors { return c.WithLevel(ErrorLevel, color) }
+
+// WithFatal is WithLevel(FatalLevel, color).
+func (c ConsoleColors) WithFatal(color string) ConsoleColors { return c.WithLevel(FatalLevel, color) }
+
 // level returns the configured color for a level name string.
 func (c ConsoleColors) level(level string) string {
 	switch strings.ToLower(level) {
@@ -186,7 +277,55 @@ func BgColor256(n int) string {
 	return fmt.Sprintf("\033[48;5;%dm", n)
 }
 
+// Compose concatenates style sequences into a single style prefix,
+// e.g. Compose(StyleBold, StyleColor256(10)). Empty entries are skipped.
+func Compose(styles ...string) string {
+	return strings.Join(styles, "")
+}
+
+// Hyperlink wraps text in an OSC 8 escape so terminals that support it render
+// a clickable link to url. Other terminals show text unchanged.
+func Hyperlink(url, text string) string {
+	return "\033]8;;" + url + "\033\\" + text + "\033]8;;\033\\"
+}
+
+// HyperlinkIf returns Hyperlink(url, text) when enabled and plain text
+// otherwise, e.g. HyperlinkIf(!cfg.NoColor, url, text).
+func HyperlinkIf(enabled bool, url, text string) string {
+	if !enabled {
+		return text
+	}
+	return Hyperlink(url, text)
+}
+
 // StripANSI removes ANSI escape sequences from s.
 func StripANSI(s string) string {
 	return ansiPattern.ReplaceAllString(s, "")
 }
+
+// StyleFrom returns the style sequence for a name such as "bold", "red",
+// "bright_cyan" or "bg_red". Names are case-insensitive; ok is false for
+// unknown names. See ListStyles for the full set.
+func StyleFrom(name string) (style string, ok bool) {
+	style, ok = styleNames[strings.ToLower(strings.TrimSpace(name))]
+	return style, ok
+}
+
+// StyleMustFrom is StyleFrom but panics on an unknown name.
+func StyleMustFrom(name string) string {
+	style, ok := StyleFrom(name)
+	if !ok {
+		panic(fmt.Sprintf("smplog: unknown style %q", name))
+	}
+	return style
+}
+
+// ListStyles returns every name accepted by StyleFrom in sorted order.
+func ListStyles() []string {
+	names := make([]string, 0, len(styleNames))
+	for name := range styleNames {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
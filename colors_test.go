@@ -0,0 +1,121 @@
+package logs
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestConsoleColorsMergeKeepsEmptyOverrides verifies empty fields in other do not overwrite the receiver.
+func TestConsoleColorsMergeKeepsEmptyOverrides(t *testing.T) {
+	base := DefaultColors()
+	red := StyleColor256(196)
+	got := base.Merge(ConsoleColors{Error: red, Fatal: StyleBold + red, Message: StyleItalic})
+
+	if got.Error != red || got.Fatal != StyleBold+red || got.Message != StyleItalic {
+		t.Fatalf("expected overrides applied, got %+v", got)
+	}
+	want := base
+	want.Error, want.Fatal, want.Message = got.Error, got.Fatal, got.Message
+	if got != want {
+		t.Fatalf("expected other fields kept:\n got %+v\nwant %+v", got, want)
+	}
+	if base.Merge(ConsoleColors{}) != base {
+		t.Fatal("expected merging an empty palette to be a no-op")
+	}
+}
+
+// TestConsoleColorsWithLevelChains verifies chained per-level setters update only their fields.
+func TestConsoleColorsWithLevelChains(t *testing.T) {
+	red, blue := StyleColor256(Red), StyleColor256(BrightBlue)
+	base := DefaultColors()
+	got := base.WithError(red).WithInfo(blue)
+
+	if got.Error != red || got.Info != blue {
+		t.Fatalf("expected error and info set, got error=%q info=%q", got.Error, got.Info)
+	}
+	if base.Info == blue {
+		t.Fatal("expected the receiver to be unchanged")
+	}
+	want := base
+	want.Error, want.Info = red, blue
+	if got != want {
+		t.Fatalf("expected other fields kept:\n got %+v\nwant %+v", got, want)
+	}
+	if c := base.WithLevel(PanicLevel, red); c.Panic != red {
+		t.Fatalf("expected WithLevel to set panic, got %q", c.Panic)
+	}
+	if base.WithLevel(NoLevel, red) != base {
+		t.Fatal("expected NoLevel to leave the palette unchanged")
+	}
+}
+
+// TestStyleFromResolvesNames verifies attribute, color, bright and background names.
+func TestStyleFromResolvesNames(t *testing.T) {
+	cases := map[string]string{
+		"bold":            StyleBold,
+		"dim":             StyleDim,
+		"red":             StyleColor256(Red),
+		"bright_red":      StyleColor256(BrightRed),
+		"bg_red":          BgColor256(Red),
+		"bg_bright_white": BgColor256(BrightWhite),
+		"  Bright_Cyan ":  StyleColor256(BrightCyan),
+	}
+	for name, want := range cases {
+		got, ok := StyleFrom(name)
+		if !ok || got != want {
+			t.Errorf("StyleFrom(%q) = %q, %v; want %q", name, got, ok, want)
+		}
+	}
+	if _, ok := StyleFrom("chartreuse"); ok {
+		t.Fatal("expected unknown name to report !ok")
+	}
+}
+
+// TestStyleMustFromPanicsOnUnknown verifies StyleMustFrom panics for unknown names.
+func TestStyleMustFromPanicsOnUnknown(t *testing.T) {
+	if StyleMustFrom("underline") != StyleUnderline {
+		t.Fatal("expected underline style")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	StyleMustFrom("nope")
+}
+
+// TestListStylesSortedAndResolvable verifies ListStyles is sorted and every name resolves.
+func TestListStylesSortedAndResolvable(t *testing.T) {
+	names := ListStyles()
+	if !slices.IsSorted(names) {
+		t.Fatal("expected sorted names")
+	}
+	if len(names) != 9+8*4 {
+		t.Fatalf("expected %d names, got %d", 9+8*4, len(names))
+	}
+	for _, name := range names {
+		if _, ok := StyleFrom(name); !ok {
+			t.Errorf("listed name %q does not resolve", name)
+		}
+	}
+}
+
+// TestHyperlinkStripsToText verifies the OSC 8 sequence and that StripANSI removes it.
+func TestHyperlinkStripsToText(t *testing.T) {
+	link := Hyperlink("https://example.com", "click")
+	if link != "\033]8;;https://example.com\033\\click\033]8;;\033\\" {
+		t.Fatalf("unexpected hyperlink %q", link)
+	}
+	if got := StripANSI(link); got != "click" {
+		t.Fatalf("expected StripANSI to leave %q, got %q", "click", got)
+	}
+	if got := StripANSI(StyleBold + link + StyleReset); got != "click" {
+		t.Fatalf("expected mixed SGR and OSC stripped, got %q", got)
+	}
+	if got := HyperlinkIf(false, "https://example.com", "click"); got != "click" {
+		t.Fatalf("expected plain text when disabled, got %q", got)
+	}
+	if got := HyperlinkIf(true, "https://example.com", "click"); got != link {
+		t.Fatalf("expected hyperlink when enabled, got %q", got)
+	}
+}
@@ -0,0 +1,111 @@
+package logs
+
+import "os"
+
+// ConditionalLogger logs through the global logger only while its predicate
+// holds. The predicate runs before every event, so it may change at runtime.
+// Create one with When, IfFlag or IfEnv.
+type ConditionalLogger struct {
+	pred func() bool
+}
+
+// When returns a ConditionalLogger that suppresses events when pred returns
+// false, replacing `if cfg.Verbose { logs.Debug(...) }` blocks:
+//
+//	verbose := logs.When(func() bool { return cfg.Verbose })
+//	verbose.Debug("cache warmed")
+//
+// A nil pred always logs.
+func When(pred func() bool) *ConditionalLogger {
+	return &ConditionalLogger{pred: pred}
+}
+
+// IfFlag returns a ConditionalLogger that logs while *flag is true. A nil
+// flag never logs.
+func IfFlag(flag *bool) *ConditionalLogger {
+	return When(func() bool { return flag != nil && *flag })
+}
+
+// IfEnv returns a ConditionalLogger that logs while the environment variable
+// key equals value. The variable is read on every event.
+func IfEnv(key, value string) *ConditionalLogger {
+	return When(func() bool {
+		v, ok := os.LookupEnv(key)
+		return ok && v == value
+	})
+}
+
+// Enabled reports whether the predicate currently holds.
+func (c *ConditionalLogger) Enabled() bool {
+	return c.pred == nil || c.pred()
+}
+
+// Trace logs a message at trace level when enabled.
+func (c *ConditionalLogger) Trace(msg string) {
+	if c.Enabled() {
+		Trace(msg)
+	}
+}
+
+// Tracef logs a formatted message at trace level when enabled.
+func (c *ConditionalLogger) Tracef(format string, v ...any) {
+	if c.Enabled() {
+		Tracef(format, v...)
+	}
+}
+
+// Debug logs a message at debug level when enabled.
+func (c *ConditionalLogger) Debug(msg string) {
+	if c.Enabled() {
+		Debug(msg)
+	}
+}
+
+// Debugf logs a formatted message at debug level when enabled.
+func (c *ConditionalLogger) Debugf(format string, v ...any) {
+	if c.Enabled() {
+		Debugf(format, v...)
+	}
+}
+
+// Info logs a message at info level when enabled.
+func (c *ConditionalLogger) Info(msg string) {
+	if c.Enabled() {
+		Info(msg)
+	}
+}
+
+// Infof logs a formatted message at info level when enabled.
+func (c *ConditionalLogger) Infof(format string, v ...any) {
+	if c.Enabled() {
+		Infof(format, v...)
+	}
+}
+
+// Warn logs a message at warn level when enabled.
+func (c *ConditionalLogger) Warn(msg string) {
+	if c.Enabled() {
+		Warn(msg)
+	}
+}
+
+// Warnf logs a formatted message at warn level when enabled.
+func (c *ConditionalLogger) Warnf(format string, v ...any) {
+	if c.Enabled() {
+		Warnf(format, v...)
+	}
+}
+
+// Error logs a message at error level with err when enabled.
+func (c *ConditionalLogger) Error(err error, msg string) {
+	if c.Enabled() {
+		Error(err, msg)
+	}
+}
+
+// Errorf logs a formatted message at error level with err when enabled.
+func (c *ConditionalLogger) Errorf(err error, format string, v ...any) {
+	if c.Enabled() {
+		Errorf(err, format, v...)
+	}
+}
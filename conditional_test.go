@@ -0,0 +1,70 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestWhenEvaluatesPredicatePerEvent verifies events follow the predicate as it changes.
+func TestWhenEvaluatesPredicatePerEvent(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: TraceLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	on := false
+	c := When(func() bool { return on })
+	c.Info("hidden")
+	c.Error(errors.New("x"), "hidden")
+	on = true
+	c.Trace("t")
+	c.Debugf("d%d", 1)
+	c.Warn("w")
+	c.Errorf(errors.New("boom"), "e%s", "!")
+
+	lines := decodeLines(t, out.String())
+	want := []string{"t", "d1", "w", "e!"}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d: %v", len(want), len(lines), lines)
+	}
+	for i, msg := range want {
+		if lines[i]["message"] != msg {
+			t.Errorf("line %d: got %v, want message %q", i, lines[i], msg)
+		}
+	}
+	if lines[3]["error"] != "boom" {
+		t.Fatalf("expected error field, got %v", lines[3])
+	}
+}
+
+// TestIfFlagAndIfEnv verifies the bool-pointer and environment conveniences.
+func TestIfFlagAndIfEnv(t *testing.T) {
+	var verbose bool
+	flag := IfFlag(&verbose)
+	if flag.Enabled() {
+		t.Fatal("expected disabled while flag is false")
+	}
+	verbose = true
+	if !flag.Enabled() {
+		t.Fatal("expected enabled once flag is true")
+	}
+	if IfFlag(nil).Enabled() {
+		t.Fatal("expected nil flag to be disabled")
+	}
+
+	env := IfEnv("SMPLOG_TEST_DEBUG", "1")
+	if env.Enabled() {
+		t.Fatal("expected disabled while unset")
+	}
+	t.Setenv("SMPLOG_TEST_DEBUG", "1")
+	if !env.Enabled() {
+		t.Fatal("expected enabled when the variable matches")
+	}
+	t.Setenv("SMPLOG_TEST_DEBUG", "0")
+	if env.Enabled() {
+		t.Fatal("expected disabled when the variable differs")
+	}
+	if !When(nil).Enabled() {
+		t.Fatal("expected nil predicate to always log")
+	}
+}
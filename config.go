@@ -2,6 +2,10 @@ package logs
 
 import (
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
@@ -9,43 +13,59 @@ import (
 // fileConfig is the TOML-decodable shape of Config.
 //
 // Fields that require code — Writer, ConfigureZerolog, ConfigureConsole,
-// ConfigureLogger — cannot be expressed in a file and must be set on the
+// ConfigureLogger, ConfigureWriter — cannot be expressed in a file and must be set on the
 // returned Config programmatically before calling Configure.
 type fileConfig struct {
-	Level      string      `toml:"level"`
-	Timestamp  bool        `toml:"timestamp"`
-	Caller     bool        `toml:"caller"`
-	Stack      bool        `toml:"stack"`
-	TimeFormat string      `toml:"time_format"`
-	NoColor    bool        `toml:"no_color"`
-	Bypass     bool        `toml:"bypass"`
-	Colors     colorConfig `toml:"colors"`
-	TUI        []tuiConfig `toml:"tui"`
-	Files      []LogFile   `toml:"files"`
+	Level              string            `toml:"level"`
+	Timestamp          bool              `toml:"timestamp"`
+	Caller             bool              `toml:"caller"`
+	Stack              bool              `toml:"stack"`
+	TimeFormat         string            `toml:"time_format"`
+	NoColor            bool              `toml:"no_color"`
+	Bypass             bool              `toml:"bypass"`
+	MaxMessageLength   int               `toml:"max_message_length"`
+	TruncationMarker   string            `toml:"truncation_marker"`
+	FieldOrder         []string          `toml:"field_order"`
+	FieldNameMap       map[string]string `toml:"field_name_map"`
+	AlwaysFields       map[string]any    `toml:"always_fields"`
+	IncludeGoroutineID bool              `toml:"include_goroutine_id"`
+	IncludeProcessInfo bool              `toml:"include_process_info"`
+	IncludeCommit      string            `toml:"include_commit"`
+	SamplingRate       float64           `toml:"sampling_rate"`
+	RedactKeys         []string          `toml:"redact_keys"`
+	SelectMaxRetries   int               `toml:"select_max_retries"`
+	RequirePassword    bool              `toml:"require_password"`
+	DedupCacheSize     int               `toml:"dedup_cache_size"`
+	HTTPSinkTimeout    time.Duration     `toml:"http_sink_timeout"`
+	HTTPSinkRetries    int               `toml:"http_sink_retries"`
+	Colors             colorConfig       `toml:"colors"`
+	TUI                []tuiConfig       `toml:"tui"`
+	Files              []LogFile         `toml:"files"`
 }
 
 // colorConfig is the [colors] section of the TOML file.
-// Each field is a 256-color palette index (0–255). Omit a field to inherit
+// Each field is a 256-color palette index (0–255) or a style name accepted
+// by StyleFrom (e.g. "bold", "bright_cyan"). Omit a field to inherit
 // the level color. Menu/CLI helpers read this same map for `menu`, `title`,
 // `prompt`, `data`, and `divider`. Use StyleColor256(n) in code for the same
 // palette.
 type colorConfig struct {
-	Trace      *int `toml:"trace"`
-	Debug      *int `toml:"debug"`
-	Info       *int `toml:"info"`
-	Warn       *int `toml:"warn"`
-	Error      *int `toml:"error"`
-	Fatal      *int `toml:"fatal"`
-	Panic      *int `toml:"panic"`
-	Message    *int `toml:"message"`
-	Timestamp  *int `toml:"timestamp"`
-	FieldName  *int `toml:"field_name"`
-	FieldValue *int `toml:"field_value"`
-	Menu       *int `toml:"menu"`
-	Title      *int `toml:"title"`
-	Prompt     *int `toml:"prompt"`
-	Data       *int `toml:"data"`
-	Divider    *int `toml:"divider"`
+	Trace      *colorValue `toml:"trace"`
+	Debug      *colorValue `toml:"debug"`
+	Info       *colorValue `toml:"info"`
+	Warn       *colorValue `toml:"warn"`
+	Error      *colorValue `toml:"error"`
+	Fatal      *colorValue `toml:"fatal"`
+	Panic      *colorValue `toml:"panic"`
+	Message    *colorValue `toml:"message"`
+	Timestamp  *colorValue `toml:"timestamp"`
+	FieldName  *colorValue `toml:"field_name"`
+	FieldValue *colorValue `toml:"field_value"`
+	Menu       *colorValue `toml:"menu"`
+	Title      *colorValue `toml:"title"`
+	Prompt     *colorValue `toml:"prompt"`
+	Data       *colorValue `toml:"data"`
+	Divider    *colorValue `toml:"divider"`
 }
 
 // tuiConfig is the [[tui]] section of the TOML file.
@@ -55,16 +75,100 @@ type tuiConfig struct {
 	MenuIndexWidth       int    `toml:"menu_index_width"`
 	InputCursor          string `toml:"input_cursor"`
 	DividerWidth         int    `toml:"divider_width"`
+	MaxWidth             int    `toml:"max_width"`
+	IndentUnit           string `toml:"indent_unit"`
+	LeftMargin           int    `toml:"left_margin"`
 }
 
-// color256 converts a nullable palette index to an ANSI escape string.
+// colorValue is one [colors] entry: the style sequence decoded from either
+// a palette index or a StyleFrom name.
+type colorValue string
+
+// UnmarshalTOML implements toml.Unmarshaler.
+func (c *colorValue) UnmarshalTOML(data any) error {
+	switch v := data.(type) {
+	case int64:
+		if v < 0 || v > 255 {
+			return fmt.Errorf("smplog: color index %d out of range 0-255", v)
+		}
+		*c = colorValue(StyleColor256(int(v)))
+	case string:
+		style, ok := StyleFrom(v)
+		if !ok {
+			return fmt.Errorf("smplog: unknown style %q", v)
+		}
+		*c = colorValue(style)
+	default:
+		return fmt.Errorf("smplog: color must be an integer or style name, got %T", data)
+	}
+	return nil
+}
+
+// MarshalTOML implements toml.Marshaler, writing a palette index when the
+// style is a single StyleColor256 sequence and a style name otherwise.
+func (c colorValue) MarshalTOML() ([]byte, error) {
+	if n := colorIndex(string(c)); n != nil {
+		return []byte(strconv.Itoa(*n)), nil
+	}
+	if name, ok := styleName(string(c)); ok {
+		return []byte(strconv.Quote(name)), nil
+	}
+	return nil, fmt.Errorf("smplog: style %q has no file representation", string(c))
+}
+
+// color256 converts a nullable [colors] entry to an ANSI escape string.
 // A nil pointer means the field was absent in the file; return empty string
 // so ConsoleColors falls back to the level color.
-func color256(p *int) string {
+func color256(p *colorValue) string {
 	if p == nil {
 		return ""
 	}
-	return StyleColor256(*p)
+	return string(*p)
+}
+
+// colorOf is the inverse of color256: it returns the entry for a single
+// StyleColor256 sequence or named style, or nil when s is empty or any
+// other style.
+func colorOf(s string) *colorValue {
+	if colorIndex(s) == nil {
+		if _, ok := styleName(s); !ok {
+			return nil
+		}
+	}
+	c := colorValue(s)
+	return &c
+}
+
+// styleName returns the StyleFrom name for s, preferring the first in
+// ListStyles order when several names share a sequence.
+func styleName(s string) (string, bool) {
+	if s == "" {
+		return "", false
+	}
+	for _, name := range ListStyles() {
+		if styleNames[name] == s {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// colorIndex returns the palette index for a single StyleColor256 sequence,
+// or nil when s is empty or any other style.
+func colorIndex(s string) *int {
+	rest, ok := strings.CutPrefix(s, "\033[38;5;")
+	if !ok {
+		return nil
+	}
+	rest, ok = strings.CutSuffix(rest, "m")
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(rest)
+	if err != nil || n < 0 || n > 255 {
+		return nil
+	}
+	return &n
 }
 
 // ConfigFromFile parses a TOML file at path and returns a Config.
@@ -89,9 +193,20 @@ func color256(p *int) string {
 //	}
 //	logs.Configure(cfg)
 func ConfigFromFile(path string) (Config, error) {
+	cfg, _, err := ConfigFromFileMask(path)
+	return cfg, err
+}
+
+// ConfigFromFileMask is ConfigFromFile but also reports which fields were
+// present in the file, so the result can be layered with MergeMasked:
+//
+//	fileCfg, mask, err := logs.ConfigFromFileMask("logger.toml")
+//	cfg := base.MergeMasked(fileCfg, mask)
+func ConfigFromFileMask(path string) (Config, ConfigMask, error) {
 	var fc fileConfig
-	if _, err := toml.DecodeFile(path, &fc); err != nil {
-		return Config{}, fmt.Errorf("smplog: parse config %q: %w", path, err)
+	md, err := toml.DecodeFile(path, &fc)
+	if err != nil {
+		return Config{}, 0, fmt.Errorf("smplog: parse config %q: %w", path, err)
 	}
 
 	var level Level
@@ -101,19 +216,36 @@ func ConfigFromFile(path string) (Config, error) {
 		var err error
 		level, err = ParseLevel(fc.Level)
 		if err != nil {
-			return Config{}, fmt.Errorf("smplog: invalid level %q in %q: %w", fc.Level, path, err)
+			return Config{}, 0, fmt.Errorf("smplog: invalid level %q in %q: %w", fc.Level, path, err)
 		}
 	}
 
 	return Config{
-		Level:      level,
-		Timestamp:  fc.Timestamp,
-		Caller:     fc.Caller,
-		Stack:      fc.Stack,
-		TimeFormat: fc.TimeFormat,
-		NoColor:    fc.NoColor,
-		Bypass:     fc.Bypass,
-		Files:      fc.Files,
+		Level:              level,
+		Timestamp:          fc.Timestamp,
+		Caller:             fc.Caller,
+		Stack:              fc.Stack,
+		TimeFormat:         fc.TimeFormat,
+		NoColor:            fc.NoColor,
+		Bypass:             fc.Bypass,
+		FieldOrder:         fc.FieldOrder,
+		FieldNameMap:       fc.FieldNameMap,
+		AlwaysFields:       fc.AlwaysFields,
+		IncludeGoroutineID: fc.IncludeGoroutineID,
+		IncludeProcessInfo: fc.IncludeProcessInfo,
+		IncludeCommit:      fc.IncludeCommit,
+		SamplingRate:       fc.SamplingRate,
+		RedactKeys:         fc.RedactKeys,
+		Files:              fc.Files,
+
+		MaxMessageLength: fc.MaxMessageLength,
+		TruncationMarker: fc.TruncationMarker,
+
+		SelectMaxRetries: fc.SelectMaxRetries,
+		RequirePassword:  fc.RequirePassword,
+		DedupCacheSize:   fc.DedupCacheSize,
+		HTTPSinkTimeout:  fc.HTTPSinkTimeout,
+		HTTPSinkRetries:  fc.HTTPSinkRetries,
 		Colors: ConsoleColors{
 			Trace:      color256(fc.Colors.Trace),
 			Debug:      color256(fc.Colors.Debug),
@@ -133,7 +265,48 @@ func ConfigFromFile(path string) (Config, error) {
 			Divider:    color256(fc.Colors.Divider),
 		},
 		TUI: parseTUIConfig(fc.TUI),
-	}, nil
+	}, fileConfigMask(md), nil
+}
+
+// fileConfigMask maps the keys defined in a decoded TOML file to ConfigMask bits.
+func fileConfigMask(md toml.MetaData) ConfigMask {
+	keys := []struct {
+		key  string
+		mask ConfigMask
+	}{
+		{"level", MaskLevel},
+		{"timestamp", MaskTimestamp},
+		{"caller", MaskCaller},
+		{"stack", MaskStack},
+		{"time_format", MaskTimeFormat},
+		{"no_color", MaskNoColor},
+		{"bypass", MaskBypass},
+		{"field_order", MaskFieldOrder},
+		{"field_name_map", MaskFieldNameMap},
+		{"always_fields", MaskAlwaysFields},
+		{"include_goroutine_id", MaskIncludeGoroutineID},
+		{"include_process_info", MaskIncludeProcessInfo},
+		{"include_commit", MaskIncludeCommit},
+		{"sampling_rate", MaskSamplingRate},
+		{"redact_keys", MaskRedactKeys},
+		{"max_message_length", MaskMaxMessageLength},
+		{"truncation_marker", MaskTruncationMarker},
+		{"select_max_retries", MaskSelectMaxRetries},
+		{"require_password", MaskRequirePassword},
+		{"dedup_cache_size", MaskDedupCacheSize},
+		{"http_sink_timeout", MaskHTTPSinkTimeout},
+		{"http_sink_retries", MaskHTTPSinkRetries},
+		{"colors", MaskColors},
+		{"tui", MaskTUI},
+		{"files", MaskFiles},
+	}
+	var mask ConfigMask
+	for _, k := range keys {
+		if md.IsDefined(k.key) {
+			mask |= k.mask
+		}
+	}
+	return mask
 }
 
 func parseTUIConfig(entries []tuiConfig) TUIConfig {
@@ -148,5 +321,74 @@ func parseTUIConfig(entries []tuiConfig) TUIConfig {
 		MenuIndexWidth:       last.MenuIndexWidth,
 		InputCursor:          last.InputCursor,
 		DividerWidth:         last.DividerWidth,
+		MaxWidth:             last.MaxWidth,
+		IndentUnit:           last.IndentUnit,
+		LeftMargin:           last.LeftMargin,
+	}
+}
+
+// DumpConfig writes cfg to w as TOML in the format read by ConfigFromFile,
+// so DumpConfig followed by ConfigFromFile round-trips the file-expressible
+// fields. Code-only fields (Writer, hooks, HTTPLevelMap) are not written.
+// Colors are written as 256-color palette indexes or StyleFrom names;
+// composed colors (e.g. bold + color) are omitted.
+func DumpConfig(w io.Writer, cfg Config) error {
+	tui := cfg.TUI
+	fc := fileConfig{
+		Level:              cfg.Level.String(),
+		Timestamp:          cfg.Timestamp,
+		Caller:             cfg.Caller,
+		Stack:              cfg.Stack,
+		TimeFormat:         cfg.TimeFormat,
+		NoColor:            cfg.NoColor,
+		Bypass:             cfg.Bypass,
+		MaxMessageLength:   cfg.MaxMessageLength,
+		TruncationMarker:   cfg.TruncationMarker,
+		FieldOrder:         cfg.FieldOrder,
+		FieldNameMap:       cfg.FieldNameMap,
+		AlwaysFields:       cfg.AlwaysFields,
+		IncludeGoroutineID: cfg.IncludeGoroutineID,
+		IncludeProcessInfo: cfg.IncludeProcessInfo,
+		IncludeCommit:      cfg.IncludeCommit,
+		SamplingRate:       cfg.SamplingRate,
+		RedactKeys:         cfg.RedactKeys,
+		SelectMaxRetries:   cfg.SelectMaxRetries,
+		RequirePassword:    cfg.RequirePassword,
+		DedupCacheSize:     cfg.DedupCacheSize,
+		HTTPSinkTimeout:    cfg.HTTPSinkTimeout,
+		HTTPSinkRetries:    cfg.HTTPSinkRetries,
+		Colors: colorConfig{
+			Trace:      colorOf(cfg.Colors.Trace),
+			Debug:      colorOf(cfg.Colors.Debug),
+			Info:       colorOf(cfg.Colors.Info),
+			Warn:       colorOf(cfg.Colors.Warn),
+			Error:      colorOf(cfg.Colors.Error),
+			Fatal:      colorOf(cfg.Colors.Fatal),
+			Panic:      colorOf(cfg.Colors.Panic),
+			Message:    colorOf(cfg.Colors.Message),
+			Timestamp:  colorOf(cfg.Colors.Timestamp),
+			FieldName:  colorOf(cfg.Colors.FieldName),
+			FieldValue: colorOf(cfg.Colors.FieldValue),
+			Menu:       colorOf(cfg.Colors.Menu),
+			Title:      colorOf(cfg.Colors.Title),
+			Prompt:     colorOf(cfg.Colors.Prompt),
+			Data:       colorOf(cfg.Colors.Data),
+			Divider:    colorOf(cfg.Colors.Divider),
+		},
+		TUI: []tuiConfig{{
+			MenuSelectedPrefix:   tui.MenuSelectedPrefix,
+			MenuUnselectedPrefix: tui.MenuUnselectedPrefix,
+			MenuIndexWidth:       tui.MenuIndexWidth,
+			InputCursor:          tui.InputCursor,
+			DividerWidth:         tui.DividerWidth,
+			MaxWidth:             tui.MaxWidth,
+			IndentUnit:           tui.IndentUnit,
+			LeftMargin:           tui.LeftMargin,
+		}},
+		Files: cfg.Files,
+	}
+	if err := toml.NewEncoder(w).Encode(fc); err != nil {
+		return fmt.Errorf("smplog: dump config: %w", err)
 	}
+	return nil
 }
@@ -1,8 +1,10 @@
 package logs
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -211,3 +213,109 @@ path = "logs/errors.log"
 		t.Errorf("files[1]: got %+v", cfg.Files[1])
 	}
 }
+
+// TestDumpConfigRoundTrip verifies DumpConfig output parses back to the same Config.
+func TestDumpConfigRoundTrip(t *testing.T) {
+	want := Config{
+		Level:              WarnLevel,
+		Timestamp:          true,
+		Caller:             true,
+		TimeFormat:         "15:04:05",
+		NoColor:            true,
+		SelectMaxRetries:   5,
+		RequirePassword:    true,
+		FieldNameMap:       map[string]string{"time": "ts", "level": "lvl"},
+		IncludeGoroutineID: true,
+		IncludeProcessInfo: true,
+		IncludeCommit:      "abc1234",
+		SamplingRate:       0.25,
+		Colors: ConsoleColors{
+			Info:    StyleColor256(4),
+			Error:   StyleColor256(196),
+			Divider: StyleColor256(240),
+			Title:   StyleBold,
+			Menu:    BgColor256(BrightBlue),
+		},
+		TUI: TUIConfig{
+			MenuSelectedPrefix:   ">>",
+			MenuUnselectedPrefix: "  ",
+			MenuIndexWidth:       3,
+			InputCursor:          "|",
+			DividerWidth:         40,
+			MaxWidth:             100,
+		},
+		Files: []LogFile{{Name: "dev", Path: "logs/dev.log"}},
+	}
+
+	var buf bytes.Buffer
+	if err := DumpConfig(&buf, want); err != nil {
+		t.Fatalf("dump: %v", err)
+	}
+	got, err := ConfigFromFile(writeTOML(t, buf.String()))
+	if err != nil {
+		t.Fatalf("parse dumped config: %v\n%s", err, buf.String())
+	}
+
+	if got.Level != want.Level || got.Timestamp != want.Timestamp || got.Caller != want.Caller ||
+		got.Stack != want.Stack || got.TimeFormat != want.TimeFormat || got.NoColor != want.NoColor ||
+		got.Bypass != want.Bypass || got.SelectMaxRetries != want.SelectMaxRetries ||
+		got.RequirePassword != want.RequirePassword || got.IncludeGoroutineID != want.IncludeGoroutineID ||
+		got.IncludeProcessInfo != want.IncludeProcessInfo || got.IncludeCommit != want.IncludeCommit ||
+		got.SamplingRate != want.SamplingRate {
+		t.Errorf("scalar fields differ:\n got %+v\nwant %+v", got, want)
+	}
+	if got.Colors != want.Colors {
+		t.Errorf("colors: got %+v, want %+v", got.Colors, want.Colors)
+	}
+	if got.TUI != want.TUI {
+		t.Errorf("tui: got %+v, want %+v", got.TUI, want.TUI)
+	}
+	if !reflect.DeepEqual(got.Files, want.Files) {
+		t.Errorf("files: got %+v, want %+v", got.Files, want.Files)
+	}
+	if !reflect.DeepEqual(got.FieldNameMap, want.FieldNameMap) {
+		t.Errorf("field_name_map: got %v, want %v", got.FieldNameMap, want.FieldNameMap)
+	}
+}
+
+// TestConfigFromFileColorStyleNames verifies [colors] accepts style names alongside indexes.
+func TestConfigFromFileColorStyleNames(t *testing.T) {
+	cfg, err := ConfigFromFile(writeTOML(t, `
+[colors]
+info  = "bright_cyan"
+title = "Bold"
+menu  = "bg_red"
+error = 196
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Colors.Info != StyleColor256(BrightCyan) || cfg.Colors.Title != StyleBold ||
+		cfg.Colors.Menu != BgColor256(Red) || cfg.Colors.Error != StyleColor256(196) {
+		t.Fatalf("unexpected colors %+v", cfg.Colors)
+	}
+
+	for _, bad := range []string{`info = "chartreuse"`, `info = 256`, `info = true`} {
+		if _, err := ConfigFromFile(writeTOML(t, "[colors]\n"+bad+"\n")); err == nil {
+			t.Errorf("expected error for %s", bad)
+		}
+	}
+}
+
+// TestConfigFromFileAlwaysFields verifies the always_fields table decodes strings and integers.
+func TestConfigFromFileAlwaysFields(t *testing.T) {
+	cfg, err := ConfigFromFile(writeTOML(t, `
+level = "info"
+
+[always_fields]
+service = "api"
+shard = 3
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]any{"service": "api", "shard": int64(3)}
+	if !reflect.DeepEqual(cfg.AlwaysFields, want) {
+		t.Fatalf("got %v, want %v", cfg.AlwaysFields, want)
+	}
+}
@@ -0,0 +1,112 @@
+package logs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// contextKey stores a *Logger in a context.Context.
+type contextKey struct{}
+
+// spanContextKey stores the innermost *spanState in a context.Context.
+type spanContextKey struct{}
+
+// spanState tracks the active span so nested spans can link to their parent
+// without stacking duplicate span_id fields on the same logger.
+type spanState struct {
+	id     string
+	base   *Logger // logger the span fields were added to
+	logger *Logger // base plus span fields; stored under contextKey
+}
+
+// loggerFromContext returns the logger stored in ctx, or the active
+// package-global logger when ctx carries none.
+func loggerFromContext(ctx context.Context) *Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(contextKey{}).(*Logger); ok && l != nil {
+			return l
+		}
+	}
+	return Zerolog()
+}
+
+// WithLogger returns a copy of ctx carrying l, for retrieval with
+// FromContext deeper in the call chain.
+func WithLogger(ctx context.Context, l Logger) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, contextKey{}, &l)
+}
+
+// FromContext returns the logger stored in ctx by WithLogger or Span, or the
+// active package-global logger when ctx carries none.
+func FromContext(ctx context.Context) Logger {
+	return *loggerFromContext(ctx)
+}
+
+// MustFromContext is FromContext but panics when ctx carries no logger.
+func MustFromContext(ctx context.Context) Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(contextKey{}).(*Logger); ok && l != nil {
+			return *l
+		}
+	}
+	panic("smplog: no logger in context")
+}
+
+// InfoCtx logs msg at info level through the logger in ctx.
+func InfoCtx(ctx context.Context, msg string) {
+	loggerFromContext(ctx).Info().Msg(msg)
+}
+
+// ErrorCtx logs msg at error level with err through the logger in ctx.
+func ErrorCtx(ctx context.Context, err error, msg string) {
+	loggerFromContext(ctx).Error().Err(err).Msg(msg)
+}
+
+// Span starts a lightweight timing span named name. The returned context
+// carries a child logger with a random "span_id" field and, when ctx already
+// holds a span, a "parent_span_id" field. The returned func logs "span_end"
+// at debug level with "span_name" and "elapsed_ms".
+//
+//	ctx, end := logs.Span(ctx, "load-user")
+//	defer end()
+func Span(ctx context.Context, name string) (context.Context, func()) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	base := loggerFromContext(ctx)
+	parent, _ := ctx.Value(spanContextKey{}).(*spanState)
+	if parent != nil && parent.logger == base {
+		base = parent.base
+	}
+
+	id := newSpanID()
+	c := base.With().Str("span_id", id)
+	if parent != nil {
+		c = c.Str("parent_span_id", parent.id)
+	}
+	l := c.Logger()
+
+	state := &spanState{id: id, base: base, logger: &l}
+	ctx = context.WithValue(ctx, contextKey{}, &l)
+	ctx = context.WithValue(ctx, spanContextKey{}, state)
+
+	start := time.Now()
+	return ctx, func() {
+		l.Debug().
+			Str("span_name", name).
+			Float64("elapsed_ms", float64(time.Since(start).Microseconds())/1000).
+			Msg("span_end")
+	}
+}
+
+// newSpanID returns a random 16-character hex identifier.
+func newSpanID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
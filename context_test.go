@@ -0,0 +1,112 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// decodeLines parses newline-delimited JSON log output.
+func decodeLines(t *testing.T, out string) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", line, err)
+		}
+		lines = append(lines, m)
+	}
+	return lines
+}
+
+// TestSpanLogsParentChildRelationship verifies nested spans link via parent_span_id.
+func TestSpanLogsParentChildRelationship(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer: &out,
+		Level:  DebugLevel,
+		Bypass: true,
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	ctx, endOuter := Span(context.Background(), "outer")
+	_, endInner := Span(ctx, "inner")
+	endInner()
+	endOuter()
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 span_end lines, got %d: %q", len(lines), out.String())
+	}
+	inner, outer := lines[0], lines[1]
+	for _, l := range lines {
+		if l["message"] != "span_end" || l["level"] != "debug" {
+			t.Fatalf("expected debug span_end event, got %v", l)
+		}
+		if _, ok := l["elapsed_ms"].(float64); !ok {
+			t.Fatalf("expected numeric elapsed_ms, got %v", l)
+		}
+	}
+	if inner["span_name"] != "inner" || outer["span_name"] != "outer" {
+		t.Fatalf("unexpected span names: inner=%v outer=%v", inner["span_name"], outer["span_name"])
+	}
+	if _, ok := outer["parent_span_id"]; ok {
+		t.Fatalf("expected no parent_span_id on root span: %v", outer)
+	}
+	if inner["parent_span_id"] != outer["span_id"] {
+		t.Fatalf("expected inner parent_span_id %v, got %v", outer["span_id"], inner["parent_span_id"])
+	}
+	if strings.Count(out.String(), `"span_id"`) != 2 {
+		t.Fatalf("expected one span_id per line: %q", out.String())
+	}
+}
+
+// TestContextLoggerRoundTrip verifies WithLogger/FromContext and the Ctx helpers.
+func TestContextLoggerRoundTrip(t *testing.T) {
+	var global, scoped bytes.Buffer
+	Configure(Config{Writer: &global, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	InfoCtx(context.Background(), "no logger")
+
+	l := New(&scoped).With().Str("request_id", "r1").Logger()
+	ctx := WithLogger(context.Background(), l)
+	InfoCtx(ctx, "scoped")
+	ErrorCtx(ctx, errors.New("boom"), "failed")
+	fromCtx := FromContext(ctx)
+	fromCtx.Info().Msg("retrieved")
+
+	if lines := decodeLines(t, global.String()); len(lines) != 1 || lines[0]["message"] != "no logger" {
+		t.Fatalf("expected global fallback, got %v", lines)
+	}
+	lines := decodeLines(t, scoped.String())
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 scoped lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if line["request_id"] != "r1" {
+			t.Fatalf("expected request_id on every line, got %v", line)
+		}
+	}
+	if lines[1]["level"] != "error" || lines[1]["error"] != "boom" {
+		t.Fatalf("unexpected error line %v", lines[1])
+	}
+}
+
+// TestMustFromContextPanicsWithoutLogger verifies MustFromContext only succeeds with a stored logger.
+func TestMustFromContextPanicsWithoutLogger(t *testing.T) {
+	ctx := WithLogger(context.Background(), New(&bytes.Buffer{}))
+	MustFromContext(ctx)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for context without logger")
+		}
+	}()
+	MustFromContext(context.Background())
+}
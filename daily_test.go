@@ -0,0 +1,62 @@
+package logs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestDailyWriterRollsOverAtMidnight verifies writes switch files when the date changes.
+func TestDailyWriterRollsOverAtMidnight(t *testing.T) {
+	defer func(fn func() time.Time) { dailyNow = fn }(dailyNow)
+	now := time.Date(2024, 3, 9, 23, 59, 0, 0, time.UTC)
+	dailyNow = func() time.Time { return now }
+
+	dir := filepath.Join(t.TempDir(), "logs")
+	w, err := NewDailyWriter(dir, "api")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	w.Write([]byte("before midnight\n"))
+	now = now.Add(2 * time.Minute)
+	w.Write([]byte("after midnight\n"))
+	w.Write([]byte("still tomorrow\n"))
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if _, err := w.Write([]byte("late\n")); err != os.ErrClosed {
+		t.Fatalf("expected os.ErrClosed after Close, got %v", err)
+	}
+
+	for name, want := range map[string]string{
+		"api-2024-03-09.log": "before midnight\n",
+		"api-2024-03-10.log": "after midnight\nstill tomorrow\n",
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if string(data) != want {
+			t.Errorf("%s: got %q, want %q", name, data, want)
+		}
+	}
+}
+
+// TestDailyWriterWithLocationUsesLocalDate verifies the file date follows loc.
+func TestDailyWriterWithLocationUsesLocalDate(t *testing.T) {
+	defer func(fn func() time.Time) { dailyNow = fn }(dailyNow)
+	dailyNow = func() time.Time { return time.Date(2024, 3, 10, 2, 0, 0, 0, time.UTC) }
+
+	dir := t.TempDir()
+	w, err := DailyWriterWithLocation(dir, "app", time.FixedZone("UTC-5", -5*3600))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	w.Write([]byte("x\n"))
+	w.Close()
+
+	if _, err := os.Stat(filepath.Join(dir, "app-2024-03-09.log")); err != nil {
+		t.Fatalf("expected file dated in the given location: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package logs
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/rs/zerolog"
+)
+
+// NewDatadogWriter wraps w so each JSON log line follows Datadog's log
+// schema: the level field becomes "status" ("fatal" as "critical", "panic"
+// as "emergency"), the timestamp field becomes "timestamp" in epoch
+// milliseconds, and "dd.service", "dd.env" and "dd.version" are appended
+// (empty values are omitted). Other lines pass through unchanged.
+//
+//	logs.Configure(logs.Config{Writer: logs.NewDatadogWriter(os.Stdout, "api", "prod", "1.4.2"), Bypass: true})
+func NewDatadogWriter(w io.Writer, service, env, version string) io.Writer {
+	var tags []jsonField
+	for _, tag := range []struct{ key, value string }{
+		{"dd.service", service},
+		{"dd.env", env},
+		{"dd.version", version},
+	} {
+		if tag.value != "" {
+			v, _ := json.Marshal(tag.value)
+			tags = append(tags, jsonField{Key: tag.key, Value: v})
+		}
+	}
+	return jsonLineWriter{w: w, fn: func(fields []jsonField) []jsonField {
+		return append(datadogFields(fields), tags...)
+	}}
+}
+
+// datadogFields renames the level and timestamp fields to Datadog's keys.
+func datadogFields(fields []jsonField) []jsonField {
+	for i, f := range fields {
+		switch f.Key {
+		case zerolog.LevelFieldName:
+			var level string
+			if json.Unmarshal(f.Value, &level) == nil {
+				fields[i].Value, _ = json.Marshal(datadogStatus(level))
+			}
+			fields[i].Key = "status"
+		case zerolog.TimestampFieldName:
+			if t, ok := parseTimestampField(f.Value); ok {
+				fields[i].Value = strconv.AppendInt(nil, t.UnixMilli(), 10)
+			}
+			fields[i].Key = "timestamp"
+		}
+	}
+	return fields
+}
+
+// datadogStatus maps a zerolog level name to a Datadog status.
+func datadogStatus(level string) string {
+	switch level {
+	case "fatal":
+		return "critical"
+	case "panic":
+		return "emergency"
+	default:
+		return level
+	}
+}
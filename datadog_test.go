@@ -0,0 +1,53 @@
+package logs
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestDatadogWriterRewritesFields verifies status, timestamp and dd.* tags.
+func TestDatadogWriterRewritesFields(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewDatadogWriter(&buf, "api", "prod", "1.4.2")
+	w.Write([]byte(`{"level":"fatal","time":"2024-01-02T03:04:05Z","message":"boom"}` + "\n"))
+
+	want := `{"status":"critical","timestamp":` +
+		strconv.FormatInt(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).UnixMilli(), 10) +
+		`,"message":"boom","dd.service":"api","dd.env":"prod","dd.version":"1.4.2"}` + "\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected line:\n got %s\nwant %s", buf.String(), want)
+	}
+}
+
+// TestDatadogWriterOmitsEmptyTags verifies empty tags are dropped and other levels kept.
+func TestDatadogWriterOmitsEmptyTags(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(NewDatadogWriter(&buf, "api", "", ""))
+	l.Warn().Msg("careful")
+
+	lines := decodeLines(t, buf.String())
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 line, got %d", len(lines))
+	}
+	line := lines[0]
+	if line["status"] != "warn" || line["dd.service"] != "api" {
+		t.Fatalf("unexpected line %v", line)
+	}
+	if _, ok := line["dd.env"]; ok {
+		t.Fatalf("expected empty dd.env to be omitted: %v", line)
+	}
+	if _, ok := line["level"]; ok {
+		t.Fatalf("expected level to be renamed: %v", line)
+	}
+}
+
+// TestDatadogWriterPassesThroughNonJSON verifies console output is untouched.
+func TestDatadogWriterPassesThroughNonJSON(t *testing.T) {
+	var buf bytes.Buffer
+	NewDatadogWriter(&buf, "api", "prod", "").Write([]byte("plain line\n"))
+	if buf.String() != "plain line\n" {
+		t.Fatalf("unexpected output %q", buf.String())
+	}
+}
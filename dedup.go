@@ -0,0 +1,120 @@
+package logs
+
+import (
+	"container/list"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+const defaultDedupCacheSize = 1000
+
+// dedupAfterFunc schedules f after d and returns a func that cancels it,
+// reporting false if f already started. Tests replace it to fire windows
+// on demand.
+var dedupAfterFunc = func(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// Dedup returns a child of the active logger that suppresses repeats of the
+// same (level, message) pair for window after it is first logged. When the
+// window ends, a single info event "N identical messages suppressed" is
+// logged if any repeats were dropped, and the next occurrence logs again.
+//
+// At most Config.DedupCacheSize distinct messages are tracked; the least
+// recently seen entry is evicted (and its summary logged) when full.
+func Dedup(window time.Duration) Logger {
+	base := *Zerolog()
+	size := Configured().DedupCacheSize
+	if size <= 0 {
+		size = defaultDedupCacheSize
+	}
+	h := &dedupHook{
+		base:    base,
+		window:  window,
+		size:    size,
+		entries: make(map[uint64]*list.Element),
+	}
+	return base.Hook(h)
+}
+
+// dedupHook is the zerolog Hook used by Dedup. entries and lru form an LRU
+// cache keyed by the hash of (level, message).
+type dedupHook struct {
+	base   Logger // logger without the hook, used for summaries
+	window time.Duration
+	size   int
+
+	mu      sync.Mutex
+	entries map[uint64]*list.Element
+	lru     list.List // of *dedupEntry, most recent first
+}
+
+type dedupEntry struct {
+	key   uint64
+	msg   string
+	count int
+	stop  func() bool
+}
+
+func (h *dedupHook) Run(e *Event, level Level, msg string) {
+	key := dedupKey(level, msg)
+
+	h.mu.Lock()
+	if el, ok := h.entries[key]; ok {
+		el.Value.(*dedupEntry).count++
+		h.lru.MoveToFront(el)
+		h.mu.Unlock()
+		e.Discard()
+		return
+	}
+	entry := &dedupEntry{key: key, msg: msg}
+	h.entries[key] = h.lru.PushFront(entry)
+	entry.stop = dedupAfterFunc(h.window, func() { h.expire(entry) })
+	var evicted *dedupEntry
+	if h.lru.Len() > h.size {
+		back := h.lru.Back()
+		evicted = back.Value.(*dedupEntry)
+		h.lru.Remove(back)
+		delete(h.entries, evicted.key)
+		if !evicted.stop() {
+			evicted = nil // expire is already running and will summarize
+		}
+	}
+	h.mu.Unlock()
+
+	if evicted != nil {
+		h.summarize(evicted.msg, evicted.count)
+	}
+}
+
+// expire removes entry once its window ends and logs the suppression summary.
+func (h *dedupHook) expire(entry *dedupEntry) {
+	h.mu.Lock()
+	if el, ok := h.entries[entry.key]; ok && el.Value == entry {
+		h.lru.Remove(el)
+		delete(h.entries, entry.key)
+	}
+	count := entry.count
+	h.mu.Unlock()
+
+	h.summarize(entry.msg, count)
+}
+
+func (h *dedupHook) summarize(msg string, count int) {
+	if count == 0 {
+		return
+	}
+	h.base.Info().
+		Str("suppressed_message", msg).
+		Int("suppressed", count).
+		Msgf("%d identical messages suppressed", count)
+}
+
+// dedupKey hashes level and msg with FNV-1a.
+func dedupKey(level Level, msg string) uint64 {
+	f := fnv.New64a()
+	f.Write([]byte{byte(level)})
+	f.Write([]byte(msg))
+	return f.Sum64()
+}
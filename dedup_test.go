@@ -0,0 +1,111 @@
+package logs
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer is a bytes.Buffer safe for writes from timer goroutines.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// fakeDedupTimers replaces dedupAfterFunc for the test; fire runs every
+// scheduled callback that has not been stopped.
+func fakeDedupTimers(t *testing.T) (fire func()) {
+	t.Helper()
+	var pending []*func()
+	orig := dedupAfterFunc
+	t.Cleanup(func() { dedupAfterFunc = orig })
+	dedupAfterFunc = func(_ time.Duration, f func()) func() bool {
+		p := &f
+		pending = append(pending, p)
+		return func() bool {
+			stopped := *p != nil
+			*p = nil
+			return stopped
+		}
+	}
+	return func() {
+		for _, p := range pending {
+			if f := *p; f != nil {
+				*p = nil
+				f()
+			}
+		}
+	}
+}
+
+// TestDedupSuppressesRepeatedMessages verifies only the first message and the summary are logged.
+func TestDedupSuppressesRepeatedMessages(t *testing.T) {
+	var out syncBuffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	fire := fakeDedupTimers(t)
+
+	l := Dedup(time.Minute)
+	for i := 0; i < 100; i++ {
+		l.Warn().Msg("disk almost full")
+	}
+	if lines := decodeLines(t, out.String()); len(lines) != 1 {
+		t.Fatalf("expected only the first message before the window ends, got %q", out.String())
+	}
+	fire()
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), out.String())
+	}
+	if lines[0]["message"] != "disk almost full" || lines[0]["level"] != "warn" {
+		t.Fatalf("expected first message logged, got %v", lines[0])
+	}
+	if lines[1]["message"] != "99 identical messages suppressed" || lines[1]["level"] != "info" {
+		t.Fatalf("expected suppression summary, got %v", lines[1])
+	}
+
+	l.Warn().Msg("disk almost full")
+	if lines := decodeLines(t, out.String()); len(lines) != 3 {
+		t.Fatalf("expected the message to log again after its window, got %q", out.String())
+	}
+}
+
+// TestDedupEvictsLeastRecentlySeen verifies DedupCacheSize bounds the tracked messages.
+func TestDedupEvictsLeastRecentlySeen(t *testing.T) {
+	var out syncBuffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, DedupCacheSize: 1})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := Dedup(time.Minute)
+	l.Info().Msg("a")
+	l.Info().Msg("a")
+	l.Info().Msg("b") // evicts "a"; its summary is logged before "b"
+	l.Info().Msg("a")
+
+	lines := decodeLines(t, out.String())
+	want := []string{"a", "1 identical messages suppressed", "b", "a"}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %q", len(want), out.String())
+	}
+	for i, msg := range want {
+		if lines[i]["message"] != msg {
+			t.Fatalf("line %d: got %v, want %q", i, lines[i]["message"], msg)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package logs
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultElasticsearchBulkSize      = 500
+	defaultElasticsearchFlushInterval = 5 * time.Second
+)
+
+// ElasticsearchConfig tunes NewElasticsearchWriterWithConfig. Zero BulkSize
+// and FlushInterval use 500 documents and 5 seconds. Username and Password
+// enable basic auth; TLSConfig customizes HTTPS connections.
+type ElasticsearchConfig struct {
+	BulkSize      int
+	FlushInterval time.Duration
+	Username      string
+	Password      string
+	TLSConfig     *tls.Config
+}
+
+// NewElasticsearchWriter returns a writer that bulk-indexes log lines into
+// index via POST <url>/<index>/_bulk. Each JSON line becomes a document;
+// other lines are indexed as {"message": line}. Document IDs hash the line's
+// timestamp, level and message, so a retried bulk request overwrites rather
+// than duplicates. Lines are sent every 5 seconds or 500 documents; Close
+// flushes.
+//
+//	w, err := logs.NewElasticsearchWriter("https://es:9200", "app-logs")
+//	logs.Configure(logs.Config{Writer: w, Bypass: true})
+//	defer w.Close()
+//
+// Delivery uses Config.HTTPSinkTimeout and Config.HTTPSinkRetries like
+// HTTPSink; a bulk response reporting item errors counts as a failure.
+func NewElasticsearchWriter(url, index string) (io.WriteCloser, error) {
+	return NewElasticsearchWriterWithConfig(url, index, ElasticsearchConfig{})
+}
+
+// NewElasticsearchWriterWithConfig is NewElasticsearchWriter with explicit
+// batching, credentials and TLS settings.
+func NewElasticsearchWriterWithConfig(url, index string, cfg ElasticsearchConfig) (io.WriteCloser, error) {
+	if err := validateSinkURL(url); err != nil {
+		return nil, err
+	}
+	if index == "" || strings.ContainsAny(index, "/ ") {
+		return nil, fmt.Errorf("smplog: invalid elasticsearch index %q", index)
+	}
+	if cfg.BulkSize < 0 {
+		return nil, fmt.Errorf("smplog: elasticsearch bulk size must not be negative, got %d", cfg.BulkSize)
+	}
+	if cfg.FlushInterval < 0 {
+		return nil, fmt.Errorf("smplog: elasticsearch flush interval must not be negative, got %v", cfg.FlushInterval)
+	}
+	if cfg.BulkSize == 0 {
+		cfg.BulkSize = defaultElasticsearchBulkSize
+	}
+	if cfg.FlushInterval == 0 {
+		cfg.FlushInterval = defaultElasticsearchFlushInterval
+	}
+
+	client := &http.Client{Timeout: Configured().HTTPSinkTimeout}
+	if cfg.TLSConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = cfg.TLSConfig
+		client.Transport = transport
+	}
+	var header http.Header
+	if cfg.Username != "" || cfg.Password != "" {
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(cfg.Username, cfg.Password)
+		header = req.Header
+	}
+	return newHTTPSink(httpSinkOptions{
+		url:           strings.TrimRight(url, "/") + "/" + neturl.PathEscape(index) + "/_bulk",
+		batchSize:     cfg.BulkSize,
+		flushInterval: cfg.FlushInterval,
+		encode:        encodeElasticsearchBulk,
+		client:        client,
+		contentType:   "application/x-ndjson",
+		header:        header,
+		check:         checkElasticsearchBulk,
+	}), nil
+}
+
+// encodeElasticsearchBulk encodes lines as a _bulk request body: an index
+// action with a deterministic _id followed by the document, per line.
+func encodeElasticsearchBulk(lines []sinkLine) []byte {
+	var buf bytes.Buffer
+	for _, l := range lines {
+		doc := l.data
+		if !json.Valid(doc) {
+			doc, _ = json.Marshal(map[string]string{zerolog.MessageFieldName: string(l.data)})
+		}
+		fmt.Fprintf(&buf, `{"index":{"_id":%q}}`+"\n", elasticsearchDocID(l))
+		buf.Write(doc)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// elasticsearchDocID hashes the line's timestamp, level and message. Lines
+// without a timestamp use the write time so distinct events stay distinct.
+func elasticsearchDocID(l sinkLine) string {
+	var ts, level, msg string
+	if fields, err := parseJSONObject(l.data); err == nil {
+		for _, f := range fields {
+			switch f.Key {
+			case zerolog.TimestampFieldName:
+				ts = string(f.Value)
+			case zerolog.LevelFieldName:
+				level = string(f.Value)
+			case zerolog.MessageFieldName:
+				msg = string(f.Value)
+			}
+		}
+	} else {
+		msg = string(l.data)
+	}
+	if ts == "" {
+		ts = l.at.Format(time.RFC3339Nano)
+	}
+	h := fnv.New128a()
+	for _, part := range []string{ts, level, msg} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// checkElasticsearchBulk fails a _bulk response that reports item errors.
+func checkElasticsearchBulk(body []byte) error {
+	var resp struct {
+		Errors bool `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("decode bulk response: %w", err)
+	}
+	if resp.Errors {
+		return fmt.Errorf("bulk response reported item errors")
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package logs
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// bulkServer records _bulk requests and answers with errors=true for the
+// first `itemErrors` requests.
+type bulkServer struct {
+	mu         sync.Mutex
+	paths      []string
+	bodies     []string
+	auth       []string
+	types      []string
+	itemErrors int
+}
+
+func (s *bulkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.paths = append(s.paths, r.URL.Path)
+	s.bodies = append(s.bodies, string(body))
+	s.auth = append(s.auth, r.Header.Get("Authorization"))
+	s.types = append(s.types, r.Header.Get("Content-Type"))
+	failed := s.itemErrors > 0
+	if failed {
+		s.itemErrors--
+	}
+	json.NewEncoder(w).Encode(map[string]any{"errors": failed})
+}
+
+// TestElasticsearchWriterBulkIndexes verifies the bulk path, auth, body format and IDs.
+func TestElasticsearchWriterBulkIndexes(t *testing.T) {
+	srv := &bulkServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	w, err := NewElasticsearchWriterWithConfig(ts.URL, "app-logs", ElasticsearchConfig{
+		BulkSize: 10, FlushInterval: time.Hour, Username: "elastic", Password: "secret",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Write([]byte(`{"level":"info","time":"2024-01-02T03:04:05Z","message":"one"}` + "\n"))
+	w.Write([]byte("plain\n"))
+	w.Close()
+
+	if len(srv.bodies) != 1 {
+		t.Fatalf("expected 1 bulk request, got %d", len(srv.bodies))
+	}
+	if srv.paths[0] != "/app-logs/_bulk" {
+		t.Fatalf("unexpected path %q", srv.paths[0])
+	}
+	if srv.types[0] != "application/x-ndjson" {
+		t.Fatalf("unexpected content type %q", srv.types[0])
+	}
+	if !strings.HasPrefix(srv.auth[0], "Basic ") {
+		t.Fatalf("expected basic auth, got %q", srv.auth[0])
+	}
+	lines := strings.Split(strings.TrimSuffix(srv.bodies[0], "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected 4 ndjson lines, got %q", lines)
+	}
+	var action struct {
+		Index struct {
+			ID string `json:"_id"`
+		} `json:"index"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &action); err != nil || action.Index.ID == "" {
+		t.Fatalf("unexpected action line %q (%v)", lines[0], err)
+	}
+	if lines[1] != `{"level":"info","time":"2024-01-02T03:04:05Z","message":"one"}` {
+		t.Fatalf("unexpected document %q", lines[1])
+	}
+	if lines[3] != `{"message":"plain"}` {
+		t.Fatalf("unexpected wrapped document %q", lines[3])
+	}
+}
+
+// TestElasticsearchWriterRetriesWithSameIDs verifies item errors trigger a retry with identical IDs.
+func TestElasticsearchWriterRetriesWithSameIDs(t *testing.T) {
+	defer func(d time.Duration) { httpSinkBackoff = d }(httpSinkBackoff)
+	httpSinkBackoff = time.Millisecond
+
+	srv := &bulkServer{itemErrors: 1}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	w, err := NewElasticsearchWriterWithConfig(ts.URL, "logs", ElasticsearchConfig{BulkSize: 10, FlushInterval: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Write([]byte(`{"level":"warn","message":"retry me"}`))
+	w.Close()
+
+	if len(srv.bodies) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(srv.bodies))
+	}
+	if srv.bodies[0] != srv.bodies[1] {
+		t.Fatalf("retry body differs:\n%s\n%s", srv.bodies[0], srv.bodies[1])
+	}
+	if srv.auth[0] != "" {
+		t.Fatalf("expected no auth header, got %q", srv.auth[0])
+	}
+}
+
+// TestElasticsearchDocIDDistinguishesEvents verifies IDs depend on timestamp, level and message.
+func TestElasticsearchDocIDDistinguishesEvents(t *testing.T) {
+	at := time.Now()
+	a := elasticsearchDocID(sinkLine{data: []byte(`{"level":"info","time":"t1","message":"m"}`), at: at})
+	b := elasticsearchDocID(sinkLine{data: []byte(`{"level":"info","time":"t1","message":"m","extra":1}`), at: at.Add(time.Second)})
+	c := elasticsearchDocID(sinkLine{data: []byte(`{"level":"info","time":"t2","message":"m"}`), at: at})
+	if a != b {
+		t.Fatalf("expected same ID for same timestamp/level/message, got %s and %s", a, b)
+	}
+	if a == c {
+		t.Fatal("expected different IDs for different timestamps")
+	}
+}
+
+// TestElasticsearchWriterRejectsBadIndex verifies index validation.
+func TestElasticsearchWriterRejectsBadIndex(t *testing.T) {
+	if _, err := NewElasticsearchWriter("http://es:9200", ""); err == nil {
+		t.Fatal("expected error for empty index")
+	}
+	if _, err := NewElasticsearchWriter("http://es:9200", "a/b"); err == nil {
+		t.Fatal("expected error for index with slash")
+	}
+}
@@ -0,0 +1,76 @@
+package logs
+
+import (
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// ErrorGroup collects errors and logs them together as one event with an
+// "errors" array field. The zero value is ready to use.
+//
+//	var errs logs.ErrorGroup
+//	for _, f := range files {
+//		errs.Add(validate(f))
+//	}
+//	if errs.Len() > 0 {
+//		errs.Log("validation failed")
+//	}
+type ErrorGroup struct {
+	errs []error
+}
+
+// Add appends err to the group. Nil errors are ignored.
+func (g *ErrorGroup) Add(err error) *ErrorGroup {
+	if err != nil {
+		g.errs = append(g.errs, err)
+	}
+	return g
+}
+
+// Len returns the number of collected errors.
+func (g *ErrorGroup) Len() int {
+	return len(g.errs)
+}
+
+// First returns the first collected error, or nil if the group is empty.
+func (g *ErrorGroup) First() error {
+	if len(g.errs) == 0 {
+		return nil
+	}
+	return g.errs[0]
+}
+
+// Error joins the collected error messages with "; ".
+func (g *ErrorGroup) Error() string {
+	msgs := make([]string, len(g.errs))
+	for i, err := range g.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the collected errors for errors.Is and errors.As.
+func (g *ErrorGroup) Unwrap() []error {
+	return g.errs
+}
+
+// MarshalZerologObject adds the "errors" array so a group can be embedded
+// in any event with EmbedObject or Object.
+func (g *ErrorGroup) MarshalZerologObject(e *Event) {
+	arr := zerolog.Arr()
+	for _, err := range g.errs {
+		arr = arr.Str(err.Error())
+	}
+	e.Array("errors", arr)
+}
+
+// Log writes msg at error level with the collected errors.
+func (g *ErrorGroup) Log(msg string) {
+	g.LogAt(ErrorLevel, msg)
+}
+
+// LogAt writes msg at level with the collected errors.
+func (g *ErrorGroup) LogAt(level Level, msg string) {
+	Zerolog().WithLevel(level).EmbedObject(g).Msg(msg)
+}
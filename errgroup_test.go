@@ -0,0 +1,37 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestErrorGroupLogsErrorsArray verifies collected errors are logged as a single array field.
+func TestErrorGroupLogsErrorsArray(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	first := errors.New("missing name")
+	var g ErrorGroup
+	g.Add(first).Add(nil).Add(errors.New("bad port"))
+
+	if g.Len() != 2 || g.First() != first {
+		t.Fatalf("expected 2 errors starting with %v, got %d/%v", first, g.Len(), g.First())
+	}
+	if g.Error() != "missing name; bad port" {
+		t.Fatalf("unexpected Error(): %q", g.Error())
+	}
+	if !errors.Is(&g, first) {
+		t.Fatal("expected errors.Is to find a collected error")
+	}
+
+	g.Log("validation failed")
+	g.LogAt(WarnLevel, "validation warnings")
+
+	if got := out.String(); got != `{"level":"error","errors":["missing name","bad port"],"message":"validation failed"}`+"\n"+
+		`{"level":"warn","errors":["missing name","bad port"],"message":"validation warnings"}`+"\n" {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
@@ -0,0 +1,74 @@
+package logs
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// EventLogger accumulates fields with short chained methods and writes them
+// as one event on Send:
+//
+//	logs.NewEventLogger(logs.InfoLevel).Str("user", id).Int("items", n).Send("checkout")
+//
+// Fields are not encoded until Send, and nothing is recorded when level is
+// disabled at creation. An EventLogger is not safe for concurrent use.
+type EventLogger struct {
+	level   Level
+	enabled bool
+	fields  []fieldPair
+}
+
+// NewEventLogger returns an EventLogger writing at level to the global
+// logger.
+func NewEventLogger(level Level) *EventLogger {
+	return &EventLogger{level: level, enabled: IsLevelEnabled(level)}
+}
+
+// Str adds a string field.
+func (l *EventLogger) Str(key, value string) *EventLogger {
+	return l.add(key, value)
+}
+
+// Int adds an int field.
+func (l *EventLogger) Int(key string, value int) *EventLogger {
+	return l.add(key, value)
+}
+
+// Bool adds a bool field.
+func (l *EventLogger) Bool(key string, value bool) *EventLogger {
+	return l.add(key, value)
+}
+
+// Time adds a time field formatted per zerolog.TimeFieldFormat.
+func (l *EventLogger) Time(key string, t time.Time) *EventLogger {
+	return l.add(key, t)
+}
+
+// Err adds err under zerolog.ErrorFieldName. A nil err is ignored.
+func (l *EventLogger) Err(err error) *EventLogger {
+	if err == nil {
+		return l
+	}
+	return l.add(zerolog.ErrorFieldName, err)
+}
+
+func (l *EventLogger) add(key string, value any) *EventLogger {
+	if l.enabled {
+		l.fields = append(l.fields, fieldPair{key: key, value: value})
+	}
+	return l
+}
+
+// Send writes the accumulated fields in order with msg at the EventLogger's
+// level. The fields are kept, so Send may be called again.
+func (l *EventLogger) Send(msg string) {
+	if !l.enabled {
+		return
+	}
+	e := Zerolog().WithLevel(l.level)
+	for _, f := range l.fields {
+		appendEventField(e, f.key, f.value)
+	}
+	e.Msg(msg)
+}
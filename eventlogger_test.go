@@ -0,0 +1,49 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestEventLoggerSendsAccumulatedFields verifies chained fields are written in order at the level.
+func TestEventLoggerSendsAccumulatedFields(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	l := NewEventLogger(WarnLevel).
+		Str("user", "bob").
+		Int("items", 3).
+		Bool("retry", true).
+		Time("at", at).
+		Err(errors.New("timeout")).
+		Err(nil)
+	l.Send("checkout slow")
+
+	want := `{"level":"warn","user":"bob","items":3,"retry":true,"at":"2024-01-02T03:04:05Z","error":"timeout","message":"checkout slow"}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+
+	out.Reset()
+	l.Send("again")
+	if lines := decodeLines(t, out.String()); lines[0]["user"] != "bob" || lines[0]["message"] != "again" {
+		t.Fatalf("expected fields kept across Send, got %v", lines[0])
+	}
+}
+
+// TestEventLoggerDisabledLevel verifies disabled levels record and write nothing.
+func TestEventLoggerDisabledLevel(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := NewEventLogger(DebugLevel).Str("k", "v")
+	l.Send("hidden")
+	if out.Len() != 0 || len(l.fields) != 0 {
+		t.Fatalf("expected nothing recorded or written, got %q and %d fields", out.String(), len(l.fields))
+	}
+}
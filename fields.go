@@ -0,0 +1,91 @@
+package logs
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// WithFields returns a child of the active logger with every entry of fields
+// added as a permanent field, in sorted key order.
+func WithFields(fields map[string]any) Logger {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	c := Zerolog().With()
+	for _, k := range keys {
+		c = appendField(c, k, fields[k])
+	}
+	return c.Logger()
+}
+
+// NewChildLogger returns a child of the active logger with permanent fields
+// taken from alternating key/value pairs:
+//
+//	l := logs.NewChildLogger("service", "api", "port", 8080)
+//
+// Non-string keys are formatted with fmt.Sprint; a trailing key without a
+// value is ignored.
+func NewChildLogger(fields ...any) Logger {
+	return ChildLoggerWith(*Zerolog(), fields...)
+}
+
+// ChildLoggerWith is NewChildLogger for an arbitrary parent logger.
+func ChildLoggerWith(l Logger, fields ...any) Logger {
+	c := l.With()
+	for _, f := range fieldPairs(fields) {
+		c = appendField(c, f.key, f.value)
+	}
+	return c.Logger()
+}
+
+// fieldPair is one key/value field taken from alternating key/value args.
+type fieldPair struct {
+	key   string
+	value any
+}
+
+// fieldPairs converts alternating key/value pairs to fieldPairs. Non-string
+// keys are formatted with fmt.Sprint; a trailing key without a value is
+// ignored.
+func fieldPairs(keyvals []any) []fieldPair {
+	pairs := make([]fieldPair, 0, len(keyvals)/2)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		key, ok := keyvals[i].(string)
+		if !ok {
+			key = fmt.Sprint(keyvals[i])
+		}
+		pairs = append(pairs, fieldPair{key: key, value: keyvals[i+1]})
+	}
+	return pairs
+}
+
+// appendField adds v to c under key using the typed zerolog method for
+// common types, falling back to Interface.
+func appendField(c Context, key string, v any) Context {
+	switch v := v.(type) {
+	case string:
+		return c.Str(key, v)
+	case int:
+		return c.Int(key, v)
+	case int64:
+		return c.Int64(key, v)
+	case bool:
+		return c.Bool(key, v)
+	case float64:
+		return c.Float64(key, v)
+	case error:
+		return c.AnErr(key, v)
+	case time.Time:
+		return c.Time(key, v)
+	case time.Duration:
+		return c.Dur(key, v)
+	case fmt.Stringer:
+		return c.Stringer(key, v)
+	default:
+		return c.Interface(key, v)
+	}
+}
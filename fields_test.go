@@ -0,0 +1,65 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// TestNewChildLoggerAddsTypedFields verifies key/value pairs are dispatched to typed fields.
+func TestNewChildLoggerAddsTypedFields(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := NewChildLogger(
+		"service", "api",
+		"port", 8080,
+		"debug", true,
+		"ratio", 0.5,
+		"err", errors.New("boom"),
+		"at", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		"timeout", 1500*time.Millisecond,
+		"ip", net.IPv4(127, 0, 0, 1),
+		"dangling",
+	)
+	l.Info().Msg("ready")
+
+	got := decodeLines(t, out.String())[0]
+	want := map[string]any{
+		"service": "api",
+		"port":    float64(8080),
+		"debug":   true,
+		"ratio":   0.5,
+		"err":     "boom",
+		"at":      "2024-01-02T03:04:05Z",
+		"timeout": float64(1500),
+		"ip":      "127.0.0.1",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("field %q: got %#v, want %#v", k, got[k], v)
+		}
+	}
+	if _, ok := got["dangling"]; ok {
+		t.Fatal("expected trailing key without value to be ignored")
+	}
+}
+
+// TestWithFieldsUsesSortedKeys verifies map fields are added in sorted key order.
+func TestWithFieldsUsesSortedKeys(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := WithFields(map[string]any{"b": 2, "a": "x"})
+	l.Info().Msg("")
+
+	if got := out.String(); got != `{"level":"info","a":"x","b":2}`+"\n" {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
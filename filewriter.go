@@ -0,0 +1,65 @@
+package logs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// NewFileWriter opens path for appending, creating it (mode 0644) and its
+// parent directories as needed, and returns a writer safe for concurrent
+// use. Use it as Config.Writer when a single file without rotation is
+// enough; see RotatingFileWriter otherwise.
+//
+//	w, err := logs.NewFileWriter("logs/app.log")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer w.Close()
+//	logs.Configure(logs.Config{Writer: w, Bypass: true})
+func NewFileWriter(path string) (io.WriteCloser, error) {
+	return NewFileWriterMode(path, 0644)
+}
+
+// NewFileWriterMode is NewFileWriter with the file mode used when the file
+// is created. Parent directories are created with mode 0755.
+func NewFileWriterMode(path string, perm os.FileMode) (io.WriteCloser, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("smplog: create log directory for %q: %w", path, err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, perm)
+	if err != nil {
+		return nil, fmt.Errorf("smplog: open log file %q: %w", path, err)
+	}
+	return &fileWriter{f: f}, nil
+}
+
+// fileWriter serializes writes to an append-only file.
+type fileWriter struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+// Write appends p. Writes after Close return os.ErrClosed.
+func (w *fileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return 0, os.ErrClosed
+	}
+	return w.f.Write(p)
+}
+
+// Close closes the file. Closing twice is a no-op.
+func (w *fileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Close()
+	w.f = nil
+	return err
+}
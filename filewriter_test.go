@@ -0,0 +1,74 @@
+package logs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestFileWriterAppendsAcrossReopen verifies lines from two opens are all kept.
+func TestFileWriterAppendsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "dir", "app.log")
+
+	w, err := NewFileWriter(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	Configure(Config{Writer: w, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	for _, msg := range []string{"one", "two", "three"} {
+		Info(msg)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if _, err := w.Write([]byte("late\n")); !errors.Is(err, os.ErrClosed) {
+		t.Fatalf("expected os.ErrClosed after Close, got %v", err)
+	}
+
+	w, err = NewFileWriter(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	Configure(Config{Writer: w, Level: InfoLevel, Bypass: true})
+	Info("four")
+	Info("five")
+	w.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	lines := decodeLines(t, string(data))
+	var got []string
+	for _, line := range lines {
+		got = append(got, line["message"].(string))
+	}
+	if strings.Join(got, ",") != "one,two,three,four,five" {
+		t.Fatalf("unexpected messages %q", got)
+	}
+}
+
+// TestFileWriterModeSetsPermissions verifies the creation mode is applied.
+func TestFileWriterModeSetsPermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix permissions")
+	}
+	path := filepath.Join(t.TempDir(), "secret.log")
+	w, err := NewFileWriterMode(path, 0600)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer w.Close()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Fatalf("expected mode 0600, got %o", perm)
+	}
+}
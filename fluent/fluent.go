@@ -0,0 +1,216 @@
+// Package fluent provides an smplog writer for the Fluentd / Fluent Bit
+// Forward protocol. It is kept out of the core logs package so only callers
+// that use it depend on MessagePack.
+package fluent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	logs "github.com/danmuck/smplog"
+	"github.com/danmuck/smplog/internal/jsonline"
+	"github.com/rs/zerolog"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	defaultTag     = "smplog"
+	dialTimeout    = 5 * time.Second
+	writeTimeout   = 5 * time.Second
+	minRedialDelay = 500 * time.Millisecond
+	maxRedialDelay = 30 * time.Second
+	eventTimeExt   = 0
+)
+
+// errRedialBackoff is returned, wrapped, by writes made while the writer
+// waits to retry a failed connection.
+var errRedialBackoff = errors.New("waiting to reconnect")
+
+// redialNow is the clock for reconnect back-off; tests replace it.
+var redialNow = time.Now
+
+// NewWriter returns a writer that forwards each log line to a Fluentd
+// or Fluent Bit forward input at host:port, tagged "smplog". See
+// NewWriterWithTag.
+func NewWriter(host string, port int) (io.WriteCloser, error) {
+	return NewWriterWithTag(host, port, defaultTag)
+}
+
+// NewWriterWithTag returns a writer that sends each log line as a
+// Forward protocol message ([tag, time, record], MessagePack-encoded) over
+// TCP. JSON lines become the record and their timestamp the event time;
+// other lines are sent as {"message": line}. A failed write reconnects and
+// retries once, so a restarted collector is picked up automatically. Each
+// send has a write deadline, and after a failed dial writes fail fast until
+// the next reconnect attempt, backing off from 500ms up to 30s.
+//
+//	w, err := fluent.NewWriterWithTag("localhost", 24224, "app.web")
+//	logs.Configure(logs.Config{Writer: w, Bypass: true})
+//	defer w.Close()
+func NewWriterWithTag(host string, port int, tag string) (io.WriteCloser, error) {
+	if tag == "" {
+		return nil, fmt.Errorf("smplog: fluent tag must not be empty")
+	}
+	w := &writer{addr: net.JoinHostPort(host, strconv.Itoa(port)), tag: tag}
+	if err := w.connect(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+type writer struct {
+	addr string
+	tag  string
+
+	mu     sync.Mutex
+	conn   net.Conn
+	closed bool
+	// redialAt is when the next dial may be attempted after a failure, and
+	// redialDelay the current back-off.
+	redialAt    time.Time
+	redialDelay time.Duration
+}
+
+func (w *writer) connect() error {
+	if now := redialNow(); now.Before(w.redialAt) {
+		return fmt.Errorf("smplog: fluent %s: %w (%s)", w.addr, errRedialBackoff, w.redialAt.Sub(now).Round(time.Millisecond))
+	}
+	conn, err := net.DialTimeout("tcp", w.addr, dialTimeout)
+	if err != nil {
+		w.redialDelay = min(max(2*w.redialDelay, minRedialDelay), maxRedialDelay)
+		w.redialAt = redialNow().Add(w.redialDelay)
+		return fmt.Errorf("smplog: connect to fluent %s: %w", w.addr, err)
+	}
+	w.conn, w.redialAt, w.redialDelay = conn, time.Time{}, 0
+	return nil
+}
+
+// send writes msg to the current connection under a write deadline, so a
+// stalled collector cannot block logging indefinitely.
+func (w *writer) send(msg []byte) error {
+	if err := w.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	_, err := w.conn.Write(msg)
+	return err
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	line := bytes.TrimSpace(p)
+	if len(line) == 0 {
+		return len(p), nil
+	}
+	msg, err := encodeMessage(w.tag, line, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return 0, logs.ErrSinkClosed
+	}
+	if w.conn != nil {
+		if err = w.send(msg); err == nil {
+			return len(p), nil
+		}
+		w.conn.Close()
+		w.conn = nil
+	}
+	if err := w.connect(); err != nil {
+		return 0, err
+	}
+	if err := w.send(msg); err != nil {
+		w.conn.Close()
+		w.conn = nil
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close closes the connection. Later writes return logs.ErrSinkClosed.
+func (w *writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.conn == nil {
+		return nil
+	}
+	return w.conn.Close()
+}
+
+// eventTime is the Forward protocol EventTime extension: seconds and
+// nanoseconds as big-endian uint32s.
+type eventTime time.Time
+
+func (t eventTime) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if err := enc.EncodeExtHeader(eventTimeExt, 8); err != nil {
+		return err
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint32(buf[:4], uint32(time.Time(t).Unix()))
+	binary.BigEndian.PutUint32(buf[4:], uint32(time.Time(t).Nanosecond()))
+	_, err := enc.Writer().Write(buf[:])
+	return err
+}
+
+// encodeMessage encodes line as a single-event Forward message.
+func encodeMessage(tag string, line []byte, now time.Time) ([]byte, error) {
+	ts := now
+	record := map[string]any{}
+	if fields, err := jsonline.ParseObject(line); err == nil {
+		for _, f := range fields {
+			if f.Key == zerolog.TimestampFieldName {
+				if t, ok := jsonline.ParseTimestamp(f.Value); ok {
+					ts = t
+				}
+			}
+			record[f.Key] = recordValue(f.Value)
+		}
+	} else {
+		record[zerolog.MessageFieldName] = string(line)
+	}
+	return msgpack.Marshal([]any{tag, eventTime(ts), record})
+}
+
+// recordValue decodes a JSON value, keeping integers as int64.
+func recordValue(raw json.RawMessage) any {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if dec.Decode(&v) != nil {
+		return string(raw)
+	}
+	return recordNumbers(v)
+}
+
+func recordNumbers(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for k, e := range v {
+			v[k] = recordNumbers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = recordNumbers(e)
+		}
+	}
+	return v
+}
@@ -0,0 +1,180 @@
+package fluent
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type message struct {
+	tag    string
+	time   time.Time
+	record map[string]any
+}
+
+// decodeMessage reads one [tag, EventTime, record] message.
+func decodeMessage(t *testing.T, dec *msgpack.Decoder) message {
+	t.Helper()
+	if n, err := dec.DecodeArrayLen(); err != nil || n != 3 {
+		t.Fatalf("expected 3-element array, got %d (%v)", n, err)
+	}
+	var m message
+	var err error
+	if m.tag, err = dec.DecodeString(); err != nil {
+		t.Fatalf("decode tag: %v", err)
+	}
+	id, n, err := dec.DecodeExtHeader()
+	if err != nil || id != eventTimeExt || n != 8 {
+		t.Fatalf("expected EventTime ext, got id=%d len=%d (%v)", id, n, err)
+	}
+	buf := make([]byte, 8)
+	if err := dec.ReadFull(buf); err != nil {
+		t.Fatalf("read EventTime: %v", err)
+	}
+	m.time = time.Unix(int64(binary.BigEndian.Uint32(buf[:4])), int64(binary.BigEndian.Uint32(buf[4:])))
+	if m.record, err = dec.DecodeMap(); err != nil {
+		t.Fatalf("decode record: %v", err)
+	}
+	return m
+}
+
+// listener starts a TCP listener and returns its host, port and a
+// channel of accepted connections.
+func listener(t *testing.T) (string, int, <-chan net.Conn) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	conns := make(chan net.Conn, 4)
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { c.Close() })
+			conns <- c
+		}
+	}()
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return host, p, conns
+}
+
+func acceptConn(t *testing.T, conns <-chan net.Conn) net.Conn {
+	t.Helper()
+	select {
+	case c := <-conns:
+		return c
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for connection")
+		return nil
+	}
+}
+
+// TestWriterSendsForwardMessages verifies tag, event time and record encoding.
+func TestWriterSendsForwardMessages(t *testing.T) {
+	host, port, conns := listener(t)
+	w, err := NewWriterWithTag(host, port, "app.web")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Close()
+	conn := acceptConn(t, conns)
+
+	w.Write([]byte(`{"level":"info","count":3,"time":"2024-01-02T03:04:05Z","message":"hello"}` + "\n"))
+	w.Write([]byte("plain text\n"))
+
+	dec := msgpack.NewDecoder(conn)
+	m := decodeMessage(t, dec)
+	if m.tag != "app.web" {
+		t.Fatalf("expected tag app.web, got %q", m.tag)
+	}
+	if !m.time.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Fatalf("unexpected event time %v", m.time)
+	}
+	if m.record["message"] != "hello" || m.record["level"] != "info" {
+		t.Fatalf("unexpected record %v", m.record)
+	}
+	if n, ok := m.record["count"].(int64); !ok || n != 3 {
+		t.Fatalf("expected integer count, got %T %v", m.record["count"], m.record["count"])
+	}
+	m = decodeMessage(t, dec)
+	if m.record["message"] != "plain text" {
+		t.Fatalf("unexpected record %v", m.record)
+	}
+}
+
+// TestWriterReconnects verifies a lost connection is re-established on the next write.
+func TestWriterReconnects(t *testing.T) {
+	host, port, conns := listener(t)
+	w, err := NewWriter(host, port)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Close()
+	acceptConn(t, conns)
+
+	fw := w.(*writer)
+	fw.mu.Lock()
+	fw.conn.Close()
+	fw.mu.Unlock()
+
+	if _, err := w.Write([]byte(`{"message":"again"}`)); err != nil {
+		t.Fatalf("expected write to reconnect, got %v", err)
+	}
+	m := decodeMessage(t, msgpack.NewDecoder(acceptConn(t, conns)))
+	if m.tag != defaultTag || m.record["message"] != "again" {
+		t.Fatalf("unexpected message %+v", m)
+	}
+}
+
+// TestWriterDialError verifies an unreachable collector is reported.
+func TestWriterDialError(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().(*net.TCPAddr)
+	ln.Close()
+	if _, err := NewWriter("127.0.0.1", addr.Port); err == nil {
+		t.Fatal("expected dial error")
+	}
+}
+
+// TestWriterBacksOffReconnects verifies writes fail fast between reconnect attempts.
+func TestWriterBacksOffReconnects(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer func(fn func() time.Time) { redialNow = fn }(redialNow)
+	redialNow = func() time.Time { return now }
+
+	w := &writer{addr: addr, tag: defaultTag}
+	if _, err := w.Write([]byte("a\n")); err == nil || errors.Is(err, errRedialBackoff) {
+		t.Fatalf("expected a dial error, got %v", err)
+	}
+	if _, err := w.Write([]byte("b\n")); !errors.Is(err, errRedialBackoff) {
+		t.Fatalf("expected the write to fail fast during back-off, got %v", err)
+	}
+
+	now = now.Add(minRedialDelay)
+	if _, err := w.Write([]byte("c\n")); err == nil || errors.Is(err, errRedialBackoff) {
+		t.Fatalf("expected a new dial attempt after the back-off, got %v", err)
+	}
+	if w.redialDelay != 2*minRedialDelay {
+		t.Fatalf("expected the back-off to double, got %v", w.redialDelay)
+	}
+}
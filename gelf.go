@@ -0,0 +1,201 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	gelfMaxChunks = 128
+	gelfChunkHead = 12
+)
+
+// gelfChunkSize is the largest UDP datagram GELFUDPWriter sends; larger
+// messages are chunked. 1420 bytes fits a typical WAN MTU.
+var gelfChunkSize = 1420
+
+// GELFWriter wraps w so each JSON log line is written as a GELF 1.1 message
+// for Graylog:
+//
+//	{"version":"1.1","host":"web-1","short_message":"started","full_message":"{...}",
+//	 "timestamp":1704164645.123,"level":6,"_port":8080}
+//
+// full_message holds the original JSON line, timestamp is parsed from the
+// timestamp field (write time when absent) as Unix seconds, level is the
+// syslog severity (see gelfLevel) and every other field is added with a
+// leading '_' ("id" becomes "__id", which GELF reserves). Lines that are not
+// JSON objects are sent with the line as short_message.
+func GELFWriter(w io.Writer, host string) io.Writer {
+	return gelfWriter{w: w, host: host}
+}
+
+type gelfWriter struct {
+	w    io.Writer
+	host string
+}
+
+func (w gelfWriter) Write(p []byte) (int, error) {
+	out := append(encodeGELF(p, w.host, time.Now()), '\n')
+	if _, err := w.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// GELFUDPWriter returns a writer that sends each log line as a GELF message
+// (see GELFWriter) to a Graylog UDP input at host:port. The message host is
+// os.Hostname. Messages larger than one datagram are split into GELF chunks;
+// messages needing more than 128 chunks are rejected with an error.
+//
+//	w, err := logs.GELFUDPWriter("graylog.internal", 12201)
+//	logs.Configure(logs.Config{Writer: w, Bypass: true})
+//	defer w.Close()
+func GELFUDPWriter(host string, port int) (io.WriteCloser, error) {
+	conn, err := net.Dial("udp", net.JoinHostPort(host, strconv.Itoa(port)))
+	if err != nil {
+		return nil, fmt.Errorf("smplog: dial gelf: %w", err)
+	}
+	hostname, _ := os.Hostname()
+	return &gelfUDPWriter{conn: conn, host: hostname}, nil
+}
+
+type gelfUDPWriter struct {
+	mu   sync.Mutex
+	conn net.Conn
+	host string
+}
+
+func (w *gelfUDPWriter) Write(p []byte) (int, error) {
+	msg := encodeGELF(p, w.host, time.Now())
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, datagram := range gelfChunks(msg, rand.Uint64()) {
+		if datagram == nil {
+			return 0, fmt.Errorf("smplog: gelf message of %d bytes exceeds %d chunks", len(msg), gelfMaxChunks)
+		}
+		if _, err := w.conn.Write(datagram); err != nil {
+			return 0, fmt.Errorf("smplog: gelf write: %w", err)
+		}
+	}
+	return len(p), nil
+}
+
+func (w *gelfUDPWriter) Close() error {
+	return w.conn.Close()
+}
+
+// gelfChunks splits msg into datagrams of at most gelfChunkSize bytes. A
+// message that fits is returned as-is; otherwise each chunk carries the GELF
+// header (magic 0x1e 0x0f, 8-byte id, sequence number, sequence count). It
+// returns a single nil datagram when msg needs more than gelfMaxChunks.
+func gelfChunks(msg []byte, id uint64) [][]byte {
+	if len(msg) <= gelfChunkSize {
+		return [][]byte{msg}
+	}
+	body := gelfChunkSize - gelfChunkHead
+	count := (len(msg) + body - 1) / body
+	if count > gelfMaxChunks {
+		return [][]byte{nil}
+	}
+	chunks := make([][]byte, 0, count)
+	for seq := 0; seq < count; seq++ {
+		part := msg[seq*body : min((seq+1)*body, len(msg))]
+		chunk := make([]byte, gelfChunkHead, gelfChunkHead+len(part))
+		chunk[0], chunk[1] = 0x1e, 0x0f
+		binary.BigEndian.PutUint64(chunk[2:10], id)
+		chunk[10], chunk[11] = byte(seq), byte(count)
+		chunks = append(chunks, append(chunk, part...))
+	}
+	return chunks
+}
+
+// encodeGELF converts one log line to a GELF 1.1 JSON message.
+func encodeGELF(p []byte, host string, now time.Time) []byte {
+	line := bytes.TrimRight(p, "\r\n")
+	fields, err := parseJSONObject(line)
+	if err != nil {
+		fields = []jsonField{{Key: zerolog.MessageFieldName, Value: jsonString(string(line))}}
+	}
+
+	ts, level, msg := now, "", ""
+	extra := make([]jsonField, 0, len(fields))
+	for _, f := range fields {
+		switch f.Key {
+		case zerolog.LevelFieldName:
+			level = prettyText(f.Value)
+		case zerolog.MessageFieldName:
+			msg = prettyText(f.Value)
+		case zerolog.TimestampFieldName:
+			if t, ok := parseTimestampField(f.Value); ok {
+				ts = t
+			}
+		default:
+			extra = append(extra, jsonField{Key: gelfFieldName(f.Key), Value: f.Value})
+		}
+	}
+
+	out := []jsonField{
+		{Key: "version", Value: jsonString("1.1")},
+		{Key: "host", Value: jsonString(host)},
+		{Key: "short_message", Value: jsonString(msg)},
+	}
+	if err == nil {
+		out = append(out, jsonField{Key: "full_message", Value: jsonString(string(line))})
+	}
+	out = append(out,
+		jsonField{Key: "timestamp", Value: strconv.AppendFloat(nil, float64(ts.UnixMicro())/1e6, 'f', -1, 64)},
+		jsonField{Key: "level", Value: strconv.AppendInt(nil, int64(gelfLevel(level)), 10)},
+	)
+	return appendJSONObject(nil, append(out, extra...))
+}
+
+// gelfLevel maps a zerolog level name to a syslog severity (0–7).
+func gelfLevel(level string) int {
+	switch level {
+	case "trace", "debug":
+		return 7
+	case "warn":
+		return 4
+	case "error":
+		return 3
+	case "fatal":
+		return 2
+	case "panic":
+		return 0
+	default:
+		return 6
+	}
+}
+
+// gelfFieldName returns key as a GELF additional field name: prefixed with
+// '_' and with characters outside [A-Za-z0-9_.-] replaced by '_'.
+func gelfFieldName(key string) string {
+	if key == "id" {
+		return "__id"
+	}
+	return "_" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+// jsonString encodes s as a JSON string value.
+func jsonString(s string) json.RawMessage {
+	b, _ := json.Marshal(s)
+	return b
+}
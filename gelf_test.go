@@ -0,0 +1,123 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestGELFWriterConvertsFields verifies GELF 1.1 core fields and prefixed additional fields.
+func TestGELFWriterConvertsFields(t *testing.T) {
+	var out bytes.Buffer
+	w := GELFWriter(&out, "web-1")
+	line := `{"level":"error","id":"r1","port":8080,"time":"2024-01-02T03:04:05Z","message":"boom"}`
+	w.Write([]byte(line + "\n"))
+
+	var msg map[string]any
+	if err := json.Unmarshal(out.Bytes(), &msg); err != nil {
+		t.Fatalf("decode %q: %v", out.String(), err)
+	}
+	want := map[string]any{
+		"version":       "1.1",
+		"host":          "web-1",
+		"short_message": "boom",
+		"full_message":  line,
+		"timestamp":     float64(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).Unix()),
+		"level":         float64(3),
+		"__id":          "r1",
+		"_port":         float64(8080),
+	}
+	if len(msg) != len(want) {
+		t.Fatalf("unexpected keys %v", msg)
+	}
+	for k, v := range want {
+		if msg[k] != v {
+			t.Errorf("%s: got %v, want %v", k, msg[k], v)
+		}
+	}
+}
+
+// TestGELFWriterWrapsPlainLines verifies non-JSON lines become short_message.
+func TestGELFWriterWrapsPlainLines(t *testing.T) {
+	var out bytes.Buffer
+	GELFWriter(&out, "h").Write([]byte("plain text\n"))
+
+	var msg map[string]any
+	if err := json.Unmarshal(out.Bytes(), &msg); err != nil {
+		t.Fatalf("decode %q: %v", out.String(), err)
+	}
+	if msg["short_message"] != "plain text" || msg["level"] != float64(6) {
+		t.Fatalf("unexpected message %v", msg)
+	}
+	if _, ok := msg["full_message"]; ok {
+		t.Fatalf("expected no full_message for a plain line: %v", msg)
+	}
+}
+
+// TestGELFUDPWriterChunksLargeMessages verifies chunk headers and reassembly over UDP.
+func TestGELFUDPWriterChunksLargeMessages(t *testing.T) {
+	defer func(n int) { gelfChunkSize = n }(gelfChunkSize)
+	gelfChunkSize = 64
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer pc.Close()
+	addr := pc.LocalAddr().(*net.UDPAddr)
+
+	w, err := GELFUDPWriter("127.0.0.1", addr.Port)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer w.Close()
+	text := strings.Repeat("x", 200)
+	if _, err := w.Write([]byte(`{"level":"info","message":"` + text + `"}`)); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	pc.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var parts [][]byte
+	buf := make([]byte, 2048)
+	for {
+		n, _, err := pc.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("read chunk %d: %v", len(parts), err)
+		}
+		chunk := append([]byte(nil), buf[:n]...)
+		if len(chunk) > gelfChunkSize || chunk[0] != 0x1e || chunk[1] != 0x0f {
+			t.Fatalf("bad chunk header % x", chunk[:gelfChunkHead])
+		}
+		if int(chunk[10]) != len(parts) {
+			t.Fatalf("expected sequence %d, got %d", len(parts), chunk[10])
+		}
+		parts = append(parts, chunk[gelfChunkHead:])
+		if len(parts) == int(chunk[11]) {
+			break
+		}
+	}
+
+	var msg map[string]any
+	if err := json.Unmarshal(bytes.Join(parts, nil), &msg); err != nil {
+		t.Fatalf("reassemble: %v", err)
+	}
+	if msg["short_message"] != text {
+		t.Fatalf("unexpected short_message %v", msg["short_message"])
+	}
+}
+
+// TestGELFChunksRejectsOversizedMessages verifies the 128-chunk limit.
+func TestGELFChunksRejectsOversizedMessages(t *testing.T) {
+	defer func(n int) { gelfChunkSize = n }(gelfChunkSize)
+	gelfChunkSize = gelfChunkHead + 1
+
+	if chunks := gelfChunks(make([]byte, gelfMaxChunks), 1); len(chunks) != gelfMaxChunks {
+		t.Fatalf("expected %d chunks, got %d", gelfMaxChunks, len(chunks))
+	}
+	if chunks := gelfChunks(make([]byte, gelfMaxChunks+1), 1); len(chunks) != 1 || chunks[0] != nil {
+		t.Fatal("expected oversized message to be rejected")
+	}
+}
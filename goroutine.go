@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// goroutineSeq allocates the "goroutine_seq" field values handed out by
+// NewGoroutineLogger.
+var goroutineSeq atomic.Int64
+
+// goroutineLoggers maps a runtime goroutine ID to the logger registered by
+// NewGoroutineLogger on that goroutine. Entries are removed by the cleanup
+// func, so exited goroutines leave nothing behind.
+var (
+	goroutineLoggersMu sync.Mutex
+	goroutineLoggers   = map[uint64]*Logger{}
+)
+
+// NewGoroutineLogger registers a child of the logger in ctx (or the global
+// logger) for the calling goroutine, tagged with a unique "goroutine_seq"
+// field. GoroutineInfo on the same goroutine logs through it. Call the
+// returned func, usually with defer, before the goroutine exits to release
+// the registration.
+//
+// goroutine_seq is a process-wide counter, one value per call, so it never
+// repeats. It is distinct from the runtime goroutine ID, which
+// Config.IncludeGoroutineID adds as "goroutine" and GoroutineIDHook as
+// "goroutine_id"; the runtime reuses those IDs after goroutines exit.
+func NewGoroutineLogger(ctx context.Context) func() {
+	child := loggerFromContext(ctx).With().Int64("goroutine_seq", goroutineSeq.Add(1)).Logger()
+	gid := goroutineID()
+	goroutineLoggersMu.Lock()
+	goroutineLoggers[gid] = &child
+	goroutineLoggersMu.Unlock()
+	return func() {
+		goroutineLoggersMu.Lock()
+		if goroutineLoggers[gid] == &child {
+			delete(goroutineLoggers, gid)
+		}
+		goroutineLoggersMu.Unlock()
+	}
+}
+
+// GoroutineInfo logs msg at info level through the logger registered by
+// NewGoroutineLogger on the calling goroutine, falling back to the logger
+// in ctx when none is registered.
+func GoroutineInfo(ctx context.Context, msg string) {
+	goroutineLoggersMu.Lock()
+	l := goroutineLoggers[goroutineID()]
+	goroutineLoggersMu.Unlock()
+	if l == nil {
+		l = loggerFromContext(ctx)
+	}
+	l.Info().Msg(msg)
+}
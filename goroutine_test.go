@@ -0,0 +1,82 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewGoroutineLoggerTagsEachGoroutine(t *testing.T) {
+	var out syncBuffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			done := NewGoroutineLogger(context.Background())
+			defer done()
+			GoroutineInfo(context.Background(), "worker")
+		}()
+	}
+	wg.Wait()
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3", len(lines))
+	}
+	seen := map[float64]bool{}
+	for _, line := range lines {
+		id, ok := line["goroutine_seq"].(float64)
+		if !ok {
+			t.Fatalf("missing goroutine_seq field: %v", line)
+		}
+		seen[id] = true
+	}
+	if len(seen) != 3 {
+		t.Fatalf("goroutine ids not unique: %v", seen)
+	}
+
+	goroutineLoggersMu.Lock()
+	n := len(goroutineLoggers)
+	goroutineLoggersMu.Unlock()
+	if n != 0 {
+		t.Fatalf("%d goroutine loggers left registered after cleanup", n)
+	}
+}
+
+func TestGoroutineInfoFallsBackToContextLogger(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	ctx := WithLogger(context.Background(), Zerolog().With().Str("req", "r1").Logger())
+	GoroutineInfo(ctx, "plain")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 1 || lines[0]["req"] != "r1" || lines[0]["goroutine_seq"] != nil {
+		t.Fatalf("unexpected output: %v", lines)
+	}
+}
+
+func TestGoroutineSeqDoesNotCollideWithGoroutineID(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, IncludeGoroutineID: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	done := NewGoroutineLogger(context.Background())
+	GoroutineInfo(context.Background(), "both")
+	done()
+
+	if n := strings.Count(out.String(), `"goroutine":`); n != 1 {
+		t.Fatalf("expected one goroutine key, got %d in %q", n, out.String())
+	}
+	line := decodeLines(t, out.String())[0]
+	if line["goroutine"] == nil || line["goroutine_seq"] == nil {
+		t.Fatalf("expected goroutine and goroutine_seq fields: %v", line)
+	}
+}
@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Histogram returns an info event on the global logger with value under key
+// and the bucket it falls into under key+"_bucket", for client-side
+// distribution logging without a metrics library:
+//
+//	logs.Histogram("latency", 0.042, []float64{0.01, 0.05, 0.1}).Msg("request")
+//	// {"latency":0.042,"latency_bucket":"≤0.05",...}
+//
+// buckets are upper bounds in ascending order; a value above the last bound
+// is labelled ">last". With no buckets only the value is added.
+func Histogram(key string, value float64, buckets []float64) *Event {
+	e := Zerolog().Info().Float64(key, value)
+	if len(buckets) > 0 {
+		e = e.Str(key+"_bucket", histogramBucket(value, buckets))
+	}
+	return e
+}
+
+// HistogramSummary returns an info event on the global logger with the
+// count and the p50, p95 and p99 of values under key+"_count", key+"_p50",
+// key+"_p95" and key+"_p99". values need not be sorted and are not modified.
+// With no values only the count is added.
+func HistogramSummary(key string, values []float64) *Event {
+	e := Zerolog().Info().Int(key+"_count", len(values))
+	if len(values) == 0 {
+		return e
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return e.Float64(key+"_p50", percentile(sorted, 50)).
+		Float64(key+"_p95", percentile(sorted, 95)).
+		Float64(key+"_p99", percentile(sorted, 99))
+}
+
+// histogramBucket returns the label of the first bucket bound >= value.
+func histogramBucket(value float64, buckets []float64) string {
+	for _, bound := range buckets {
+		if value <= bound {
+			return "≤" + strconv.FormatFloat(bound, 'g', -1, 64)
+		}
+	}
+	return ">" + strconv.FormatFloat(buckets[len(buckets)-1], 'g', -1, 64)
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted, which must
+// be non-empty and ascending.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank, 1), len(sorted))-1]
+}
@@ -0,0 +1,62 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestHistogramAddsValueAndBucket verifies bucket labels below, on and above the bounds.
+func TestHistogramAddsValueAndBucket(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	buckets := []float64{0.01, 0.05, 0.1}
+	Histogram("latency", 0.042, buckets).Msg("a")
+	Histogram("latency", 0.1, buckets).Msg("b")
+	Histogram("latency", 2.5, buckets).Msg("c")
+	Histogram("latency", 1, nil).Msg("d")
+
+	lines := decodeLines(t, out.String())
+	want := []any{"≤0.05", "≤0.1", ">0.1", nil}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d", len(want), len(lines))
+	}
+	for i, bucket := range want {
+		if got := lines[i]["latency_bucket"]; got != bucket {
+			t.Errorf("line %d: bucket %v, want %v", i, got, bucket)
+		}
+	}
+	if lines[0]["latency"] != 0.042 {
+		t.Fatalf("expected raw value, got %v", lines[0])
+	}
+}
+
+// TestHistogramSummaryPercentiles verifies nearest-rank percentiles on unsorted input.
+func TestHistogramSummaryPercentiles(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	values := make([]float64, 100)
+	for i := range values {
+		values[i] = float64(100 - i)
+	}
+	HistogramSummary("rt", values).Msg("summary")
+	HistogramSummary("rt", nil).Msg("empty")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	got := lines[0]
+	if got["rt_count"] != float64(100) || got["rt_p50"] != float64(50) || got["rt_p95"] != float64(95) || got["rt_p99"] != float64(99) {
+		t.Fatalf("unexpected summary %v", got)
+	}
+	if values[0] != 100 {
+		t.Fatal("expected input slice to be left unsorted")
+	}
+	if _, ok := lines[1]["rt_p50"]; ok || lines[1]["rt_count"] != float64(0) {
+		t.Fatalf("unexpected empty summary %v", lines[1])
+	}
+}
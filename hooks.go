@@ -0,0 +1,136 @@
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// NewFieldHook returns a hook that adds key to every event with the value
+// returned by valueFn at emit time. Values are encoded with the same typed
+// fields as WithFields. Install it with Config.Hooks or Logger.Hook:
+//
+//	cfg.Hooks = []logs.Hook{logs.NewFieldHook("queue_depth", func() any { return q.Len() })}
+func NewFieldHook(key string, valueFn func() any) Hook {
+	return fieldHook{key: key, fn: valueFn}
+}
+
+type fieldHook struct {
+	key string
+	fn  func() any
+}
+
+func (h fieldHook) Run(e *Event, _ Level, _ string) {
+	if e.Enabled() {
+		appendEventField(e, h.key, h.fn())
+	}
+}
+
+// NewLevelHook returns a hook that calls fn with the level and final message
+// of every event at minLevel or above (events logged without a level are
+// skipped). fn runs synchronously on the logging goroutine; start a
+// goroutine in fn for slow work such as alerting.
+//
+//	cfg.Hooks = []logs.Hook{logs.NewLevelHook(logs.ErrorLevel, func(level logs.Level, msg string) {
+//		go alerts.Notify(level.String(), msg)
+//	})}
+func NewLevelHook(minLevel Level, fn func(level Level, msg string)) Hook {
+	return zerolog.HookFunc(func(e *Event, level Level, msg string) {
+		if level >= minLevel && level != NoLevel && e.Enabled() {
+			fn(level, msg)
+		}
+	})
+}
+
+// GoroutineIDHook returns a hook that adds the emitting goroutine's ID as
+// "goroutine_id".
+func GoroutineIDHook() Hook {
+	return NewFieldHook("goroutine_id", func() any { return int64(goroutineID()) })
+}
+
+// MemStatHook returns a hook that adds the heap allocation in MiB as
+// "alloc_mb". runtime.ReadMemStats stops the world, so the value is sampled
+// at most once per interval and reused in between.
+func MemStatHook(interval time.Duration) Hook {
+	s := &memSampler{interval: interval}
+	return NewFieldHook("alloc_mb", s.allocMB)
+}
+
+type memSampler struct {
+	interval time.Duration
+
+	mu      sync.Mutex
+	sampled time.Time
+	alloc   uint64
+}
+
+func (s *memSampler) allocMB() any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now := time.Now(); s.sampled.IsZero() || now.Sub(s.sampled) >= s.interval {
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		s.alloc = ms.Alloc
+		s.sampled = now
+	}
+	return float64(s.alloc) / (1 << 20)
+}
+
+// HostnameHook returns a hook that adds os.Hostname as "hostname". The name
+// is looked up once, on the first event; nothing is added if it fails.
+func HostnameHook() Hook {
+	hostname := sync.OnceValue(func() string {
+		name, _ := os.Hostname()
+		return name
+	})
+	return zerolog.HookFunc(func(e *Event, _ Level, _ string) {
+		if name := hostname(); name != "" && e.Enabled() {
+			e.Str("hostname", name)
+		}
+	})
+}
+
+// goroutineID returns the current goroutine's ID, parsed from its stack
+// header ("goroutine 18 [running]:"), or 0 if it cannot be parsed.
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i > 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
+
+// appendEventField is appendField for events.
+func appendEventField(e *Event, key string, v any) {
+	switch v := v.(type) {
+	case string:
+		e.Str(key, v)
+	case int:
+		e.Int(key, v)
+	case int64:
+		e.Int64(key, v)
+	case bool:
+		e.Bool(key, v)
+	case float64:
+		e.Float64(key, v)
+	case error:
+		e.AnErr(key, v)
+	case time.Time:
+		e.Time(key, v)
+	case time.Duration:
+		e.Dur(key, v)
+	case fmt.Stringer:
+		e.Stringer(key, v)
+	default:
+		e.Interface(key, v)
+	}
+}
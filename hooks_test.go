@@ -0,0 +1,154 @@
+package logs
+
+import (
+	"bytes"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestConfigHooksAddComputedFields verifies Config.Hooks run with emit-time values.
+func TestConfigHooksAddComputedFields(t *testing.T) {
+	var out bytes.Buffer
+	var n atomic.Int64
+	Configure(Config{
+		Writer: &out,
+		Level:  InfoLevel,
+		Bypass: true,
+		Hooks: []Hook{
+			NewFieldHook("seq", func() any { return int(n.Add(1)) }),
+			NewFieldHook("elapsed", func() any { return 2 * time.Second }),
+		},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Msg("first")
+	Zerolog().Info().Msg("second")
+	Zerolog().Debug().Msg("filtered")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	if lines[0]["seq"] != float64(1) || lines[1]["seq"] != float64(2) {
+		t.Fatalf("expected seq 1 and 2, got %v and %v", lines[0]["seq"], lines[1]["seq"])
+	}
+	if lines[0]["elapsed"] != float64(2000) {
+		t.Fatalf("expected elapsed as duration field, got %v", lines[0]["elapsed"])
+	}
+	if n.Load() != 2 {
+		t.Fatalf("expected valueFn skipped for filtered events, called %d times", n.Load())
+	}
+}
+
+// TestBuiltinHooks verifies the goroutine, memory and hostname hooks.
+func TestBuiltinHooks(t *testing.T) {
+	var out bytes.Buffer
+	l := New(&out).Hook(GoroutineIDHook()).Hook(MemStatHook(time.Minute)).Hook(HostnameHook())
+	l.Info().Msg("one")
+	l.Info().Msg("two")
+
+	lines := decodeLines(t, out.String())
+	if id, ok := lines[0]["goroutine_id"].(float64); !ok || id <= 0 {
+		t.Fatalf("expected positive goroutine_id, got %v", lines[0]["goroutine_id"])
+	}
+	if mb, ok := lines[0]["alloc_mb"].(float64); !ok || mb <= 0 {
+		t.Fatalf("expected positive alloc_mb, got %v", lines[0]["alloc_mb"])
+	}
+	if lines[0]["alloc_mb"] != lines[1]["alloc_mb"] {
+		t.Fatal("expected alloc_mb reused within the sampling interval")
+	}
+	if host, _ := os.Hostname(); lines[0]["hostname"] != host {
+		t.Fatalf("expected hostname %q, got %v", host, lines[0]["hostname"])
+	}
+}
+
+// TestGoroutineIDDiffersAcrossGoroutines verifies goroutineID identifies the caller.
+func TestGoroutineIDDiffersAcrossGoroutines(t *testing.T) {
+	main := goroutineID()
+	other := make(chan uint64)
+	go func() { other <- goroutineID() }()
+	if id := <-other; main == 0 || id == 0 || id == main {
+		t.Fatalf("expected distinct non-zero IDs, got %d and %d", main, id)
+	}
+}
+
+// TestIncludeGoroutineIDTagsEmittingGoroutine verifies Config.IncludeGoroutineID adds a per-goroutine "goroutine" field.
+func TestIncludeGoroutineIDTagsEmittingGoroutine(t *testing.T) {
+	var out syncBuffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, IncludeGoroutineID: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Zerolog().Info().Msg("worker")
+			Zerolog().Info().Msg("worker")
+		}()
+	}
+	wg.Wait()
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 8 {
+		t.Fatalf("expected 8 lines, got %d", len(lines))
+	}
+	counts := map[float64]int{}
+	for _, line := range lines {
+		id, ok := line["goroutine"].(float64)
+		if !ok || id <= 0 {
+			t.Fatalf("expected positive goroutine field, got %v", line["goroutine"])
+		}
+		counts[id]++
+	}
+	if len(counts) != 4 {
+		t.Fatalf("expected 4 distinct goroutine IDs, got %v", counts)
+	}
+	for id, n := range counts {
+		if n != 2 {
+			t.Fatalf("expected 2 events from goroutine %v, got %d", id, n)
+		}
+	}
+
+	var plain bytes.Buffer
+	Configure(Config{Writer: &plain, Level: InfoLevel, Bypass: true})
+	Zerolog().Info().Msg("off")
+	if _, ok := decodeLines(t, plain.String())[0]["goroutine"]; ok {
+		t.Fatal("expected no goroutine field when disabled")
+	}
+}
+
+// TestLevelHookCountsInvocations verifies fn runs once per event at or above minLevel with the final message.
+func TestLevelHookCountsInvocations(t *testing.T) {
+	var out bytes.Buffer
+	var calls []string
+	Configure(Config{
+		Writer: &out,
+		Level:  DebugLevel,
+		Bypass: true,
+		Hooks: []Hook{NewLevelHook(WarnLevel, func(level Level, msg string) {
+			calls = append(calls, level.String()+":"+msg)
+		})},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := Zerolog()
+	l.Debug().Msg("quiet")
+	l.Info().Msg("normal")
+	l.Warn().Msgf("disk %d%% full", 91)
+	l.Error().Msg("failed")
+	l.Log().Msg("no level")
+
+	want := []string{"warn:disk 91% full", "error:failed"}
+	if len(calls) != len(want) {
+		t.Fatalf("expected %d invocations, got %d: %v", len(want), len(calls), calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("call %d: got %q, want %q", i, calls[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHTTPSinkTimeout = 10 * time.Second
+	defaultHTTPSinkRetries = 3
+	// httpSinkMaxBatches bounds the lines an HTTPSink holds to this many
+	// batches, so a dead endpoint cannot grow memory without limit.
+	httpSinkMaxBatches = 100
+)
+
+// httpSinkBackoff is the delay before the first HTTPSink retry; it doubles
+// on each further retry. It is a variable so tests can shorten it.
+var httpSinkBackoff = 200 * time.Millisecond
+
+// ErrSinkClosed is returned by writes to a closed sink.
+var ErrSinkClosed = errors.New("smplog: sink is closed")
+
+// HTTPSink returns a writer that batches log lines and POSTs them to url as
+// a JSON array, every flushInterval or as soon as batchSize lines are
+// pending. Lines that are not JSON (console mode) are sent as JSON strings.
+// Use it as Config.Writer, alone or via MultiLevelWriter:
+//
+//	sink, err := logs.HTTPSink("https://logs.example.com/ingest", 100, 5*time.Second)
+//	logs.Configure(logs.Config{Writer: sink, Bypass: true})
+//	defer sink.Close()
+//
+// Requests use Config.HTTPSinkTimeout and are retried Config.HTTPSinkRetries
+// times with exponential back-off; a batch that still fails is dropped and
+// reported on stderr. While 100 batches are already pending, new lines are
+// dropped. Dropped lines are counted in LogMetrics().EventsDropped. Close
+// flushes pending lines, stops the flusher and returns the error of the
+// last batch that was dropped.
+func HTTPSink(url string, batchSize int, flushInterval time.Duration) (io.WriteCloser, error) {
+	if err := validateSinkURL(url); err != nil {
+		return nil, err
+	}
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("smplog: http sink batch size must be positive, got %d", batchSize)
+	}
+	if flushInterval <= 0 {
+		return nil, fmt.Errorf("smplog: http sink flush interval must be positive, got %v", flushInterval)
+	}
+	return newHTTPSink(httpSinkOptions{
+		url:           url,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		encode:        encodeJSONArray,
+	}), nil
+}
+
+// httpSinkOptions configures newHTTPSink. Only url, batchSize,
+// flushInterval and encode are required.
+type httpSinkOptions struct {
+	url           string
+	batchSize     int
+	flushInterval time.Duration
+	encode        func([]sinkLine) []byte
+
+	client      *http.Client // nil uses Config.HTTPSinkTimeout
+	contentType string       // "" means application/json
+	header      http.Header  // added to every request
+	// check inspects a 2xx response body; an error fails the request.
+	check func([]byte) error
+}
+
+// newHTTPSink starts a batching sink that POSTs encode(batch) to opts.url.
+func newHTTPSink(opts httpSinkOptions) *httpSink {
+	cfg := Configured()
+	if opts.client == nil {
+		opts.client = &http.Client{Timeout: cfg.HTTPSinkTimeout}
+	}
+	if opts.contentType == "" {
+		opts.contentType = "application/json"
+	}
+	s := &httpSink{
+		opts:    opts,
+		retries: max(cfg.HTTPSinkRetries, 0),
+		full:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.run(opts.flushInterval)
+	return s
+}
+
+// sinkLine is one buffered log line and the time it was written.
+type sinkLine struct {
+	data []byte
+	at   time.Time
+}
+
+// encodeJSONArray encodes lines as a JSON array. Lines that are not JSON
+// are encoded as JSON strings.
+func encodeJSONArray(lines []sinkLine) []byte {
+	items := make([]json.RawMessage, len(lines))
+	for i, l := range lines {
+		items[i] = jsonLineItem(l.data)
+	}
+	body, _ := json.Marshal(items)
+	return body
+}
+
+func validateSinkURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("smplog: invalid sink url %q: %w", raw, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("smplog: invalid sink url %q: want http(s)://host/...", raw)
+	}
+	return nil
+}
+
+type httpSink struct {
+	opts    httpSinkOptions
+	retries int
+
+	mu      sync.Mutex
+	pending []sinkLine
+	closed  bool
+	lastErr error // error of the last dropped batch, returned by Close
+
+	full      chan struct{} // signals the flusher that batchSize was reached
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func (s *httpSink) Write(p []byte) (int, error) {
+	line := bytes.TrimSpace(p)
+	if len(line) == 0 {
+		return len(p), nil
+	}
+	item := sinkLine{data: append([]byte(nil), line...), at: time.Now()}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return 0, ErrSinkClosed
+	}
+	if len(s.pending) >= s.opts.batchSize*httpSinkMaxBatches {
+		s.mu.Unlock()
+		metricsDropped.Add(1)
+		return 0, nil
+	}
+	s.pending = append(s.pending, item)
+	full := len(s.pending) >= s.opts.batchSize
+	s.mu.Unlock()
+
+	if full {
+		select {
+		case s.full <- struct{}{}:
+		default:
+		}
+	}
+	return len(p), nil
+}
+
+// Close flushes pending lines and stops the background flusher. It returns
+// the error of the last batch that was dropped, if any.
+func (s *httpSink) Close() error {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+		close(s.done)
+		s.wg.Wait()
+		s.flush()
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastErr
+}
+
+func (s *httpSink) run(interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		case <-s.full:
+		}
+		s.flush()
+	}
+}
+
+// flush POSTs pending lines in batches of at most batchSize.
+func (s *httpSink) flush() {
+	for {
+		s.mu.Lock()
+		n := min(len(s.pending), s.opts.batchSize)
+		batch := s.pending[:n:n]
+		s.pending = s.pending[n:]
+		s.mu.Unlock()
+		if n == 0 {
+			return
+		}
+		body := s.opts.encode(batch)
+		if err := sendWithRetry(s.retries, func() error { return s.post(body) }); err != nil {
+			fmt.Fprintf(os.Stderr, "smplog: http sink dropped %d events: %v\n", n, err)
+			metricsDropped.Add(int64(n))
+			s.mu.Lock()
+			s.lastErr = fmt.Errorf("smplog: http sink dropped %d events: %w", n, err)
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *httpSink) post(body []byte) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.opts.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.opts.header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", s.opts.contentType)
+	resp, err := s.opts.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		io.Copy(io.Discard, resp.Body)
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if s.opts.check == nil {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return s.opts.check(respBody)
+}
+
+// sendWithRetry calls send until it succeeds, retrying up to retries times
+// with exponential back-off starting at httpSinkBackoff.
+func sendWithRetry(retries int, send func() error) error {
+	delay := httpSinkBackoff
+	err := send()
+	for i := 0; err != nil && i < retries; i++ {
+		time.Sleep(delay)
+		delay *= 2
+		err = send()
+	}
+	return err
+}
@@ -0,0 +1,159 @@
+package logs
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// sinkServer records the JSON arrays POSTed to it and fails the first
+// `failures` requests with a 500.
+type sinkServer struct {
+	mu       sync.Mutex
+	bodies   []string
+	failures int
+	requests int
+}
+
+func (s *sinkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if s.failures > 0 {
+		s.failures--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	s.bodies = append(s.bodies, string(body))
+}
+
+// snapshot decodes the successful request bodies.
+func (s *sinkServer) snapshot() (batches [][]any, requests int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, body := range s.bodies {
+		var batch []any
+		json.Unmarshal([]byte(body), &batch)
+		batches = append(batches, batch)
+	}
+	return batches, s.requests
+}
+
+// TestHTTPSinkBatchesAndFlushesOnClose verifies full batches post immediately and Close sends the rest.
+func TestHTTPSinkBatchesAndFlushesOnClose(t *testing.T) {
+	srv := &sinkServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	sink, err := HTTPSink(ts.URL, 2, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	l := New(sink)
+	l.Info().Msg("one")
+	l.Info().Msg("two")
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if batches, _ := srv.snapshot(); len(batches) == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for full batch")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	l.Info().Msg("three")
+	if err := sink.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if _, err := sink.Write([]byte("{}\n")); err != ErrSinkClosed {
+		t.Fatalf("expected ErrSinkClosed after Close, got %v", err)
+	}
+
+	batches, _ := srv.snapshot()
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+	if batches[0][1].(map[string]any)["message"] != "two" || batches[1][0].(map[string]any)["message"] != "three" {
+		t.Fatalf("unexpected batch contents: %v", batches)
+	}
+}
+
+// TestHTTPSinkRetriesFailedPosts verifies failed POSTs are retried per Config.HTTPSinkRetries.
+func TestHTTPSinkRetriesFailedPosts(t *testing.T) {
+	oldBackoff := httpSinkBackoff
+	httpSinkBackoff = time.Millisecond
+	t.Cleanup(func() { httpSinkBackoff = oldBackoff })
+
+	Configure(Config{HTTPSinkRetries: 2})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	srv := &sinkServer{failures: 2}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	sink, err := HTTPSink(ts.URL, 10, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sink.Write([]byte("plain text line\n"))
+	sink.Close()
+
+	batches, requests := srv.snapshot()
+	if requests != 3 || len(batches) != 1 || len(batches[0]) != 1 || batches[0][0] != "plain text line" {
+		t.Fatalf("expected success on third attempt, got %d requests, batches %v", requests, batches)
+	}
+
+	if _, err := HTTPSink("ftp://example.com", 1, time.Second); err == nil {
+		t.Fatal("expected error for non-http url")
+	}
+}
+
+// TestHTTPSinkBoundsPendingAndReportsDrops verifies a stuck endpoint caps pending lines and Close reports the loss.
+func TestHTTPSinkBoundsPendingAndReportsDrops(t *testing.T) {
+	oldBackoff := httpSinkBackoff
+	httpSinkBackoff = time.Millisecond
+	t.Cleanup(func() { httpSinkBackoff = oldBackoff })
+
+	Configure(Config{HTTPSinkRetries: -1})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case started <- struct{}{}:
+			<-release
+		default:
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	sink, err := HTTPSink(ts.URL, 1, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sink.Write([]byte("first\n"))
+	<-started // the flusher holds "first" in a stuck POST
+
+	dropped := LogMetrics().EventsDropped
+	for i := 0; i < httpSinkMaxBatches+5; i++ {
+		sink.Write([]byte("line\n"))
+	}
+	if got := LogMetrics().EventsDropped - dropped; got != 5 {
+		t.Fatalf("expected 5 lines dropped past the pending cap, got %d", got)
+	}
+
+	close(release)
+	if err := sink.Close(); err == nil {
+		t.Fatal("expected Close to report the dropped batches")
+	}
+}
@@ -0,0 +1,80 @@
+// Package jsonline decodes zerolog JSON log lines for smplog and its sink
+// subpackages.
+package jsonline
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Field is one key/value pair of a JSON log line. Value holds the raw
+// encoded JSON so untouched fields are written back byte-for-byte.
+type Field struct {
+	Key   string
+	Value json.RawMessage
+}
+
+// ErrNotObject is returned by ParseObject for lines that are not a JSON object.
+var ErrNotObject = errors.New("smplog: log line is not a JSON object")
+
+// ParseObject decodes a single JSON object, preserving key order.
+func ParseObject(line []byte) ([]Field, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return nil, ErrNotObject
+	}
+	var fields []Field
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, ErrNotObject
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, Field{Key: key, Value: value})
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// ParseTimestamp decodes a timestamp encoded per zerolog.TimeFieldFormat.
+func ParseTimestamp(raw json.RawMessage) (time.Time, bool) {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		t, err := time.Parse(zerolog.TimeFieldFormat, s)
+		return t, err == nil
+	}
+	n, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	switch zerolog.TimeFieldFormat {
+	case zerolog.TimeFormatUnix:
+		return time.Unix(n, 0), true
+	case zerolog.TimeFormatUnixMs:
+		return time.UnixMilli(n), true
+	case zerolog.TimeFormatUnixMicro:
+		return time.UnixMicro(n), true
+	case zerolog.TimeFormatUnixNano:
+		return time.Unix(0, n), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,166 @@
+package logs
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/danmuck/smplog/internal/jsonline"
+	"github.com/rs/zerolog"
+)
+
+// jsonField is one key/value pair of a JSON log line. Value holds the raw
+// encoded JSON so untouched fields are written back byte-for-byte.
+type jsonField = jsonline.Field
+
+// parseJSONObject decodes a single JSON object, preserving key order.
+func parseJSONObject(line []byte) ([]jsonField, error) {
+	return jsonline.ParseObject(line)
+}
+
+// parseTimestampField decodes a timestamp encoded per zerolog.TimeFieldFormat.
+func parseTimestampField(raw json.RawMessage) (time.Time, bool) {
+	return jsonline.ParseTimestamp(raw)
+}
+
+// appendJSONObject encodes fields as a JSON object onto dst.
+func appendJSONObject(dst []byte, fields []jsonField) []byte {
+	dst = append(dst, '{')
+	for i, f := range fields {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		key, _ := json.Marshal(f.Key)
+		dst = append(dst, key...)
+		dst = append(dst, ':')
+		dst = append(dst, f.Value...)
+	}
+	return append(dst, '}')
+}
+
+// jsonLineWriter rewrites each JSON log line with fn before forwarding it.
+// zerolog emits exactly one event per Write, so every call is one line.
+// Lines that are not JSON objects are forwarded unchanged.
+type jsonLineWriter struct {
+	w  io.Writer
+	fn func([]jsonField) []jsonField
+}
+
+func (w jsonLineWriter) Write(p []byte) (int, error) {
+	return w.rewrite(p, w.w.Write)
+}
+
+// WriteLevel rewrites p like Write and forwards level when w is a
+// zerolog.LevelWriter.
+func (w jsonLineWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	lw, ok := w.w.(zerolog.LevelWriter)
+	if !ok {
+		return w.Write(p)
+	}
+	return w.rewrite(p, func(out []byte) (int, error) { return lw.WriteLevel(level, out) })
+}
+
+// rewrite applies w.fn to the JSON line p and passes the result to write.
+// Lines that are not JSON objects are passed through unchanged.
+func (w jsonLineWriter) rewrite(p []byte, write func([]byte) (int, error)) (int, error) {
+	fields, err := parseJSONObject(p)
+	if err != nil {
+		return write(p)
+	}
+	out := appendJSONObject(make([]byte, 0, len(p)), w.fn(fields))
+	out = append(out, '\n')
+	if _, err := write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// orderFields returns a rewrite that moves the keys in order to the front,
+// in that order. Remaining fields keep their original order.
+func orderFields(order []string) func([]jsonField) []jsonField {
+	return func(fields []jsonField) []jsonField {
+		out := make([]jsonField, 0, len(fields))
+		used := make([]bool, len(fields))
+		for _, key := range order {
+			for i, f := range fields {
+				if !used[i] && f.Key == key {
+					out = append(out, f)
+					used[i] = true
+					break
+				}
+			}
+		}
+		for i, f := range fields {
+			if !used[i] {
+				out = append(out, f)
+			}
+		}
+		return out
+	}
+}
+
+// renameFields returns a rewrite that renames keys found in names
+// (source → destination). Other fields are left untouched.
+func renameFields(names map[string]string) func([]jsonField) []jsonField {
+	return func(fields []jsonField) []jsonField {
+		for i, f := range fields {
+			if to, ok := names[f.Key]; ok {
+				fields[i].Key = to
+			}
+		}
+		return fields
+	}
+}
+
+// truncateMessageField returns a rewrite that clips the message field with
+// truncateMessage. Non-string messages are left untouched.
+func truncateMessageField(limit int, marker string) func([]jsonField) []jsonField {
+	return func(fields []jsonField) []jsonField {
+		for i, f := range fields {
+			if f.Key != zerolog.MessageFieldName {
+				continue
+			}
+			var msg string
+			if err := json.Unmarshal(f.Value, &msg); err != nil {
+				break
+			}
+			if clipped := truncateMessage(msg, limit, marker); clipped != msg {
+				fields[i].Value, _ = json.Marshal(clipped)
+			}
+			break
+		}
+		return fields
+	}
+}
+
+// rewriteTimestampField returns a rewrite that replaces the timestamp field
+// with now(), encoded per zerolog.TimeFieldFormat.
+func rewriteTimestampField(now func() time.Time) func([]jsonField) []jsonField {
+	return func(fields []jsonField) []jsonField {
+		for i, f := range fields {
+			if f.Key == zerolog.TimestampFieldName {
+				fields[i].Value = encodeTimestamp(now())
+				break
+			}
+		}
+		return fields
+	}
+}
+
+// encodeTimestamp encodes t the way zerolog encodes timestamp fields.
+func encodeTimestamp(t time.Time) json.RawMessage {
+	switch zerolog.TimeFieldFormat {
+	case zerolog.TimeFormatUnix:
+		return strconv.AppendInt(nil, t.Unix(), 10)
+	case zerolog.TimeFormatUnixMs:
+		return strconv.AppendInt(nil, t.UnixMilli(), 10)
+	case zerolog.TimeFormatUnixMicro:
+		return strconv.AppendInt(nil, t.UnixMicro(), 10)
+	case zerolog.TimeFormatUnixNano:
+		return strconv.AppendInt(nil, t.UnixNano(), 10)
+	default:
+		b, _ := json.Marshal(t.Format(zerolog.TimeFieldFormat))
+		return b
+	}
+}
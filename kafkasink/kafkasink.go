@@ -0,0 +1,138 @@
+// Package kafkasink provides an smplog writer that produces log lines to a
+// Kafka topic with segmentio/kafka-go. It is kept out of the core logs
+// package so only callers that use it depend on kafka-go.
+package kafkasink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/danmuck/smplog/internal/jsonline"
+	"github.com/rs/zerolog"
+	"github.com/segmentio/kafka-go"
+)
+
+// Config tunes NewWriterWithConfig.
+//
+// Async makes Write return without waiting for the broker; failed sends are
+// then counted in Stats.DroppedMessages. BatchTimeout bounds how long a
+// partial batch waits before it is sent (0 uses kafka-go's 1s).
+// RequiredAcks is the acknowledgement level: 0 none, 1 leader, -1 all.
+type Config struct {
+	Async        bool
+	BatchTimeout time.Duration
+	RequiredAcks int
+}
+
+// Stats is a snapshot of Writer counters.
+type Stats struct {
+	DroppedMessages int64
+}
+
+// Writer produces log lines to a Kafka topic. See NewWriter.
+type Writer struct {
+	w       *kafka.Writer
+	async   bool
+	dropped atomic.Int64
+}
+
+// NewWriter returns a writer that produces each log line as a message
+// on topic, keyed by the line's zerolog level so each level stays ordered
+// within one partition. It sends asynchronously with leader
+// acknowledgement; use NewWriterWithConfig for other settings.
+//
+//	w, err := kafkasink.NewWriter([]string{"kafka:9092"}, "app-logs")
+//	logs.Configure(logs.Config{Writer: w, Bypass: true})
+//	defer w.Close()
+func NewWriter(brokers []string, topic string) (io.WriteCloser, error) {
+	return NewWriterWithConfig(brokers, topic, Config{Async: true, RequiredAcks: int(kafka.RequireOne)})
+}
+
+// NewWriterWithConfig is NewWriter with explicit settings. Lines are
+// batched by kafka-go; in synchronous mode each Write blocks until its batch
+// is acknowledged and returns the send error.
+func NewWriterWithConfig(brokers []string, topic string, cfg Config) (*Writer, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("smplog: kafka writer needs at least one broker")
+	}
+	if topic == "" {
+		return nil, errors.New("smplog: kafka topic must not be empty")
+	}
+	switch cfg.RequiredAcks {
+	case int(kafka.RequireNone), int(kafka.RequireOne), int(kafka.RequireAll):
+	default:
+		return nil, fmt.Errorf("smplog: kafka required acks must be -1, 0 or 1, got %d", cfg.RequiredAcks)
+	}
+	kw := &Writer{async: cfg.Async}
+	kw.w = &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		BatchTimeout: cfg.BatchTimeout,
+		RequiredAcks: kafka.RequiredAcks(cfg.RequiredAcks),
+		Async:        cfg.Async,
+		Completion:   kw.completion,
+	}
+	return kw, nil
+}
+
+func (kw *Writer) Write(p []byte) (int, error) {
+	line := bytes.TrimRight(p, "\r\n")
+	if len(line) == 0 {
+		return len(p), nil
+	}
+	msg := kafka.Message{Key: messageKey(line), Value: append([]byte(nil), line...)}
+	if err := kw.w.WriteMessages(context.Background(), msg); err != nil {
+		// An async writer can still fail up front, e.g. resolving the
+		// topic's partitions; count that as dropped too.
+		if kw.async {
+			kw.dropped.Add(1)
+		}
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close flushes pending messages and closes the producer.
+func (kw *Writer) Close() error {
+	return kw.w.Close()
+}
+
+// WriterStats returns the writer's counters.
+func (kw *Writer) WriterStats() Stats {
+	return Stats{DroppedMessages: kw.dropped.Load()}
+}
+
+// completion counts failed asynchronous batch sends; synchronous failures
+// are returned from Write instead.
+func (kw *Writer) completion(messages []kafka.Message, err error) {
+	if err == nil || !kw.async {
+		return
+	}
+	kw.dropped.Add(int64(len(messages)))
+	fmt.Fprintf(os.Stderr, "smplog: kafka dropped %d messages: %v\n", len(messages), err)
+}
+
+// messageKey returns the level of a JSON log line, or nil.
+func messageKey(line []byte) []byte {
+	fields, err := jsonline.ParseObject(line)
+	if err != nil {
+		return nil
+	}
+	for _, f := range fields {
+		if f.Key == zerolog.LevelFieldName {
+			var level string
+			if json.Unmarshal(f.Value, &level) == nil && level != "" {
+				return []byte(level)
+			}
+		}
+	}
+	return nil
+}
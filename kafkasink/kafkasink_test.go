@@ -0,0 +1,75 @@
+package kafkasink
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// downTransport fails every Kafka request, simulating an unreachable cluster.
+type downTransport struct{}
+
+func (downTransport) RoundTrip(context.Context, net.Addr, kafka.Request) (kafka.Response, error) {
+	return nil, errors.New("broker down")
+}
+
+// failingWriter returns a Writer whose sends fail on the first attempt.
+func failingWriter(t *testing.T, async bool) *Writer {
+	t.Helper()
+	kw, err := NewWriterWithConfig([]string{"127.0.0.1:9092"}, "logs", Config{Async: async, BatchTimeout: time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	kw.w.Transport = downTransport{}
+	kw.w.MaxAttempts = 1
+	return kw
+}
+
+// TestWriterAsyncCountsDropped verifies failed async sends are counted in WriterStats.
+func TestWriterAsyncCountsDropped(t *testing.T) {
+	kw := failingWriter(t, true)
+	kw.Write([]byte(`{"level":"info","message":"one"}` + "\n"))
+	kw.Close()
+	if got := kw.WriterStats().DroppedMessages; got != 1 {
+		t.Fatalf("expected 1 dropped message, got %d", got)
+	}
+}
+
+// TestWriterSyncReturnsError verifies synchronous failures surface from Write.
+func TestWriterSyncReturnsError(t *testing.T) {
+	kw := failingWriter(t, false)
+	defer kw.Close()
+	if _, err := kw.Write([]byte(`{"level":"info","message":"one"}`)); err == nil {
+		t.Fatal("expected write error")
+	}
+	if got := kw.WriterStats().DroppedMessages; got != 0 {
+		t.Fatalf("expected no dropped count in sync mode, got %d", got)
+	}
+}
+
+// TestMessageKeyUsesLevel verifies the message key is the line's level.
+func TestMessageKeyUsesLevel(t *testing.T) {
+	if got := string(messageKey([]byte(`{"level":"warn","message":"x"}`))); got != "warn" {
+		t.Fatalf("expected key warn, got %q", got)
+	}
+	if got := messageKey([]byte("not json")); got != nil {
+		t.Fatalf("expected nil key, got %q", got)
+	}
+}
+
+// TestWriterRejectsBadConfig verifies broker, topic and acks validation.
+func TestWriterRejectsBadConfig(t *testing.T) {
+	if _, err := NewWriter(nil, "logs"); err == nil {
+		t.Fatal("expected error for no brokers")
+	}
+	if _, err := NewWriter([]string{"b:9092"}, ""); err == nil {
+		t.Fatal("expected error for empty topic")
+	}
+	if _, err := NewWriterWithConfig([]string{"b:9092"}, "logs", Config{RequiredAcks: 2}); err == nil {
+		t.Fatal("expected error for invalid acks")
+	}
+}
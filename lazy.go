@@ -0,0 +1,40 @@
+package logs
+
+import "github.com/rs/zerolog"
+
+// Lazy returns a child of the active logger that uses fn() as the message of
+// events sent without one (Send or Msg("")). fn runs only for events that
+// pass the level filter, so expensive formatting costs nothing when the
+// level is suppressed:
+//
+//	l := logs.Lazy(func() string { return dump(state) })
+//	l.Debug().Send()
+//
+// Events sent with a non-empty message keep it and do not call fn.
+func Lazy(fn func() string) Logger {
+	return Zerolog().Hook(lazyMessageHook(fn))
+}
+
+// LazyFields returns a child of the active logger that adds the fields
+// returned by fn to every event that passes the level filter. fn is not
+// called for suppressed events.
+func LazyFields(fn func() map[string]any) Logger {
+	return Zerolog().Hook(lazyFieldsHook(fn))
+}
+
+// lazyMessageHook is the zerolog Hook used by Lazy. zerolog runs hooks only
+// for enabled events, before the message field is written.
+type lazyMessageHook func() string
+
+func (fn lazyMessageHook) Run(e *Event, _ Level, msg string) {
+	if msg == "" {
+		e.Str(zerolog.MessageFieldName, fn())
+	}
+}
+
+// lazyFieldsHook is the zerolog Hook used by LazyFields.
+type lazyFieldsHook func() map[string]any
+
+func (fn lazyFieldsHook) Run(e *Event, _ Level, _ string) {
+	e.Fields(fn())
+}
@@ -0,0 +1,55 @@
+package logs
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// TestLazyEvaluatesOnlyEnabledEvents verifies fn runs only when the event is written.
+func TestLazyEvaluatesOnlyEnabledEvents(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	calls := 0
+	l := Lazy(func() string { calls++; return "expensive" })
+	l.Debug().Send()
+	if calls != 0 {
+		t.Fatalf("expected fn not to run for suppressed events, ran %d times", calls)
+	}
+	l.Info().Send()
+	l.Info().Msg("explicit")
+	if calls != 1 {
+		t.Fatalf("expected fn to run once, ran %d times", calls)
+	}
+
+	fieldCalls := 0
+	lf := LazyFields(func() map[string]any { fieldCalls++; return map[string]any{"n": 1} })
+	lf.Debug().Msg("hidden")
+	lf.Info().Msg("shown")
+	if fieldCalls != 1 {
+		t.Fatalf("expected field fn to run once, ran %d times", fieldCalls)
+	}
+
+	want := `{"level":"info","message":"expensive"}` + "\n" +
+		`{"level":"info","message":"explicit"}` + "\n" +
+		`{"level":"info","n":1,"message":"shown"}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output: %q", out.String())
+	}
+}
+
+// BenchmarkLazySuppressed measures a lazy log call below the configured level.
+func BenchmarkLazySuppressed(b *testing.B) {
+	Configure(Config{Writer: io.Discard, Level: InfoLevel, Bypass: true})
+	b.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := Lazy(func() string { return "never built" })
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Debug().Send()
+	}
+}
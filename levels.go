@@ -0,0 +1,81 @@
+package logs
+
+import "fmt"
+
+// ParseLevelOr parses s with ParseLevel and returns fallback when s is empty
+// or not a valid level. Numeric forms such as "-1" or "3" are accepted.
+//
+//	level := logs.ParseLevelOr(os.Getenv("LOG_LEVEL"), logs.InfoLevel)
+func ParseLevelOr(s string, fallback Level) Level {
+	if s == "" {
+		return fallback
+	}
+	level, err := ParseLevel(s)
+	if err != nil {
+		return fallback
+	}
+	return level
+}
+
+// MustParseLevel parses s with ParseLevel and panics if s is not a valid level.
+// Intended for package-level vars and init() where a bad level is a programming error.
+func MustParseLevel(s string) Level {
+	level, err := ParseLevel(s)
+	if err != nil {
+		panic(fmt.Sprintf("smplog: invalid level %q: %v", s, err))
+	}
+	return level
+}
+
+// LevelFromHTTPStatus maps an HTTP status code to a log level.
+// Config.HTTPLevelMap is checked first; otherwise codes map by range:
+//   - 1xx: TraceLevel
+//   - 2xx: DebugLevel
+//   - 3xx: WarnLevel
+//   - 4xx: WarnLevel
+//   - 5xx: ErrorLevel
+//
+// Codes outside 100..599 map to InfoLevel.
+func LevelFromHTTPStatus(code int) Level {
+	if level, ok := Configured().HTTPLevelMap[code]; ok {
+		return level
+	}
+	switch {
+	case code >= 100 && code < 200:
+		return TraceLevel
+	case code >= 200 && code < 300:
+		return DebugLevel
+	case code >= 300 && code < 500:
+		return WarnLevel
+	case code >= 500 && code < 600:
+		return ErrorLevel
+	default:
+		return InfoLevel
+	}
+}
+
+// IsLevelEnabled reports whether an event at level would be written by the
+// package-global logger, considering both its level and GlobalLevel. Use it
+// to skip building expensive messages:
+//
+//	if logs.IsDebugEnabled() {
+//		logs.Debug(dump(state))
+//	}
+func IsLevelEnabled(level Level) bool {
+	return Zerolog().GetLevel() <= level && GlobalLevel() <= level
+}
+
+// IsTraceEnabled reports whether trace events are written.
+func IsTraceEnabled() bool { return IsLevelEnabled(TraceLevel) }
+
+// IsDebugEnabled reports whether debug events are written.
+func IsDebugEnabled() bool { return IsLevelEnabled(DebugLevel) }
+
+// IsInfoEnabled reports whether info events are written.
+func IsInfoEnabled() bool { return IsLevelEnabled(InfoLevel) }
+
+// IsWarnEnabled reports whether warn events are written.
+func IsWarnEnabled() bool { return IsLevelEnabled(WarnLevel) }
+
+// IsErrorEnabled reports whether error events are written.
+func IsErrorEnabled() bool { return IsLevelEnabled(ErrorLevel) }
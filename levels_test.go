@@ -0,0 +1,84 @@
+package logs
+
+import "testing"
+
+// TestParseLevelOr verifies valid names and numeric forms parse, and invalid input falls back.
+func TestParseLevelOr(t *testing.T) {
+	cases := []struct {
+		in   string
+		want Level
+	}{
+		{"debug", DebugLevel},
+		{"WARN", WarnLevel},
+		{"-1", TraceLevel},
+		{"3", ErrorLevel},
+		{"", WarnLevel},
+		{"verbose", WarnLevel},
+		{"999", WarnLevel},
+	}
+	for _, c := range cases {
+		if got := ParseLevelOr(c.in, WarnLevel); got != c.want {
+			t.Errorf("ParseLevelOr(%q): got %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+// TestMustParseLevelPanicsOnInvalid verifies MustParseLevel panics for unknown levels.
+func TestMustParseLevelPanicsOnInvalid(t *testing.T) {
+	if got := MustParseLevel("error"); got != ErrorLevel {
+		t.Fatalf("MustParseLevel(error): got %v, want %v", got, ErrorLevel)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for invalid level")
+		}
+	}()
+	MustParseLevel("verbose")
+}
+
+// TestLevelFromHTTPStatus verifies range-based mapping and Config.HTTPLevelMap overrides.
+func TestLevelFromHTTPStatus(t *testing.T) {
+	Configure(Config{
+		HTTPLevelMap: map[int]Level{404: DebugLevel},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	cases := map[int]Level{
+		101: TraceLevel,
+		200: DebugLevel,
+		301: WarnLevel,
+		400: WarnLevel,
+		404: DebugLevel,
+		503: ErrorLevel,
+		0:   InfoLevel,
+	}
+	for code, want := range cases {
+		if got := LevelFromHTTPStatus(code); got != want {
+			t.Errorf("LevelFromHTTPStatus(%d): got %v, want %v", code, got, want)
+		}
+	}
+}
+
+// TestIsLevelEnabled verifies the logger level and the global level are both honored.
+func TestIsLevelEnabled(t *testing.T) {
+	Configure(Config{Writer: NopWriter(), Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	if IsTraceEnabled() || IsDebugEnabled() {
+		t.Fatal("expected trace and debug disabled at info level")
+	}
+	if !IsInfoEnabled() || !IsWarnEnabled() || !IsErrorEnabled() {
+		t.Fatal("expected info and above enabled")
+	}
+
+	prev := GlobalLevel()
+	SetGlobalLevel(ErrorLevel)
+	t.Cleanup(func() { SetGlobalLevel(prev) })
+	if IsWarnEnabled() || !IsErrorEnabled() {
+		t.Fatal("expected the global level to disable warn")
+	}
+	if !IsLevelEnabled(FatalLevel) {
+		t.Fatal("expected fatal enabled")
+	}
+}
@@ -0,0 +1,105 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// LogfmtWriter wraps w so each JSON log line is written as logfmt:
+//
+//	level=info msg="server started" addr=:8080 tags="[\"a\",\"b\"]"
+//
+// Fields keep their original order. zerolog.MessageFieldName is written as
+// "msg" and zerolog.LevelFieldName as "level". JSON strings are unquoted,
+// arrays and objects are written as their JSON text, and values containing
+// spaces, '=', '"' or control characters are quoted. Key characters logfmt
+// cannot represent are replaced with '_'. Lines that are not JSON objects
+// pass through unchanged.
+func LogfmtWriter(w io.Writer) io.Writer {
+	return logfmtWriter{w: w}
+}
+
+type logfmtWriter struct {
+	w io.Writer
+}
+
+func (w logfmtWriter) Write(p []byte) (int, error) {
+	fields, err := parseJSONObject(p)
+	if err != nil {
+		return w.w.Write(p)
+	}
+	if _, err := w.w.Write(appendLogfmtLine(nil, fields)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// appendLogfmtLine encodes fields as one logfmt line onto dst.
+func appendLogfmtLine(dst []byte, fields []jsonField) []byte {
+	for i, f := range fields {
+		if i > 0 {
+			dst = append(dst, ' ')
+		}
+		key := f.Key
+		switch key {
+		case zerolog.MessageFieldName:
+			key = "msg"
+		case zerolog.LevelFieldName:
+			key = "level"
+		}
+		dst = appendLogfmtKey(dst, key)
+		dst = append(dst, '=')
+		dst = appendLogfmtValue(dst, f.Value)
+	}
+	return append(dst, '\n')
+}
+
+// appendLogfmtKey writes key with characters outside the logfmt identifier
+// set (space, '=', '"' and control characters) replaced by '_'.
+func appendLogfmtKey(dst []byte, key string) []byte {
+	if key == "" {
+		return append(dst, '_')
+	}
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if c <= ' ' || c == '=' || c == '"' || c == 0x7f {
+			c = '_'
+		}
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// appendLogfmtValue writes a raw JSON value: strings are unquoted, other
+// values use their JSON text, and either is quoted when logfmt requires it.
+func appendLogfmtValue(dst []byte, raw json.RawMessage) []byte {
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		s = string(raw)
+	}
+	if !logfmtNeedsQuote(s) {
+		return append(dst, s...)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return append(dst, bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})...)
+}
+
+// logfmtNeedsQuote reports whether s must be quoted to parse back as one
+// logfmt value. Empty strings are quoted so the key keeps an explicit value.
+func logfmtNeedsQuote(s string) bool {
+	if s == "" {
+		return true
+	}
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c <= ' ' || c == '=' || c == '"' || c == '\\' || c == 0x7f {
+			return true
+		}
+	}
+	return false
+}
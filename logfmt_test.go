@@ -0,0 +1,75 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kr/logfmt"
+)
+
+type logfmtPair struct{ key, value string }
+
+// parseLogfmt decodes one logfmt line into ordered key/value pairs.
+func parseLogfmt(t *testing.T, line string) []logfmtPair {
+	t.Helper()
+	var pairs []logfmtPair
+	err := logfmt.Unmarshal([]byte(line), logfmt.HandlerFunc(func(key, val []byte) error {
+		pairs = append(pairs, logfmtPair{string(key), string(val)})
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("parse %q: %v", line, err)
+	}
+	return pairs
+}
+
+// TestLogfmtWriterRoundTrips verifies kr/logfmt parses the output back to the original values.
+func TestLogfmtWriterRoundTrips(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: LogfmtWriter(&out), Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().
+		Str("addr", ":8080").
+		Str("note", `say "hi" = ok`).
+		Str("path", `C:\tmp`).
+		Str("empty", "").
+		Int("n", 3).
+		Interface("tags", []string{"a", "b"}).
+		Interface("meta", map[string]int{"x": 1}).
+		Msg("server started")
+
+	want := []logfmtPair{
+		{"level", "info"},
+		{"addr", ":8080"},
+		{"note", `say "hi" = ok`},
+		{"path", `C:\tmp`},
+		{"empty", ""},
+		{"n", "3"},
+		{"tags", `["a","b"]`},
+		{"meta", `{"x":1}`},
+		{"msg", "server started"},
+	}
+	got := parseLogfmt(t, out.String())
+	if len(got) != len(want) {
+		t.Fatalf("expected %d pairs, got %v from %q", len(want), got, out.String())
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("pair %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+// TestLogfmtWriterEscapesKeysAndQuotes verifies key sanitizing, quoting rules and passthrough.
+func TestLogfmtWriterEscapesKeysAndQuotes(t *testing.T) {
+	var out bytes.Buffer
+	w := LogfmtWriter(&out)
+	w.Write([]byte(`{"level":"warn","bad key=":"v","plain":"x","message":"a b"}`))
+	w.Write([]byte("not json\n"))
+
+	want := "level=warn bad_key_=v plain=x msg=\"a b\"\nnot json\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"math/rand"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/rs/zerolog"
 )
@@ -25,6 +29,10 @@ type Config struct {
 	Caller bool
 	// Stack appends stack traces when Stack() is used on events.
 	Stack bool
+	// TimestampFunc, when set, supplies the value of the timestamp field for
+	// this logger instead of the process-wide zerolog.TimestampFunc
+	// (e.g. a fixed clock in tests). Requires Timestamp.
+	TimestampFunc func() time.Time
 	// TimeFormat controls timestamp rendering in console mode.
 	// For bypass/JSON mode set zerolog.TimeFieldFormat via SetTimeFieldFormat.
 	TimeFormat string
@@ -32,10 +40,72 @@ type Config struct {
 	NoColor bool
 	// Bypass disables the console wrapper and emits raw zerolog JSON.
 	Bypass bool
+	// MaxMessageLength truncates log messages to this many runes, including
+	// TruncationMarker. Zero disables truncation.
+	MaxMessageLength int
+	// TruncationMarker is appended to truncated messages. Defaults to "…".
+	TruncationMarker string
+	// FieldOrder lists JSON keys emitted first, in this order, in bypass mode
+	// (e.g. "time", "level", "message"). Other fields keep their natural order.
+	FieldOrder []string
+	// FieldNameMap renames fields in output, source → destination (e.g.
+	// "time" → "ts", "level" → "lvl"). In bypass mode keys are renamed in the
+	// JSON line, after RedactKeys/MaskRules and before FieldOrder (which uses
+	// the renamed keys). In console mode only named fields are renamed; the
+	// timestamp, level, caller and message columns are unaffected.
+	FieldNameMap map[string]string
+	// AlwaysFields are static fields added to every event, in key order, using
+	// the same typed encoding as WithFields. MergeFrom/MergeMasked merge this
+	// map key by key instead of replacing it.
+	AlwaysFields map[string]any
+	// IncludeGoroutineID adds the emitting goroutine's ID to every event as
+	// "goroutine", parsed from the runtime.Stack header.
+	IncludeGoroutineID bool
+	// IncludeProcessInfo adds "pid" and "hostname" context fields, read once
+	// per Configure. hostname is omitted if os.Hostname fails.
+	IncludeProcessInfo bool
+	// IncludeCommit, when non-empty, is added to every event as "commit",
+	// e.g. a build commit hash set via -ldflags.
+	IncludeCommit string
+	// SamplingRate, when between 0 and 1, passes each event through with that
+	// probability via a SamplingWriter; other values (including the zero
+	// default) keep every event.
+	SamplingRate float64
+	// SamplingRng, when set, supplies SamplingRate's random numbers (e.g. a
+	// fixed seed in tests). It is used under a lock.
+	SamplingRng *rand.Rand
+	// RedactKeys lists top-level field keys whose values are replaced with
+	// "[REDACTED]" in every event, in both console and bypass mode.
+	RedactKeys []string
+	// MaskRules rewrite parts of top-level field values in every event, in
+	// both console and bypass mode (e.g. masking all but the last four card
+	// digits). Rules run in order, after RedactKeys.
+	MaskRules []MaskRule
 	// Colors controls per-level ANSI colors in console mode.
 	Colors ConsoleColors
 	// TUI controls compact menu/TUI rendering helpers in printf/tui_engine.
 	TUI TUIConfig
+	// SelectMaxRetries is how many times Select re-prompts after invalid input.
+	// Zero uses the default of 3.
+	SelectMaxRetries int
+	// RequirePassword makes Password and PasswordWithMask return
+	// ErrPasswordEmpty for empty input.
+	RequirePassword bool
+	// DedupCacheSize bounds how many distinct messages a Dedup logger tracks.
+	// Zero uses the default of 1000.
+	DedupCacheSize int
+	// HTTPSinkTimeout bounds each HTTPSink POST. Zero uses the default of 10s.
+	HTTPSinkTimeout time.Duration
+	// HTTPSinkRetries is how many times HTTPSink retries a failed POST, with
+	// exponential back-off, before dropping the batch. Zero uses the default
+	// of 3; a negative value disables retries.
+	HTTPSinkRetries int
+	// Hooks are added to the logger in order after it is built, before
+	// ConfigureLogger (e.g. NewFieldHook, GoroutineIDHook).
+	Hooks []Hook
+	// HTTPLevelMap overrides LevelFromHTTPStatus for specific status codes
+	// (e.g. 404 → DebugLevel). Codes not present use the range-based mapping.
+	HTTPLevelMap map[int]Level
 	// Files lists named log file destinations available to WriteFile.
 	// Each entry is opened for append/create when Configure is called.
 	Files []LogFile
@@ -48,6 +118,15 @@ type Config struct {
 	// ConfigureLogger is called after the logger is built.
 	// Use it to inject permanent context fields (e.g. service name).
 	ConfigureLogger func(l Logger) Logger
+	// ConfigureWriter wraps the writer that receives zerolog's JSON lines,
+	// after JSON rewrites and ahead of the ConsoleWriter in console mode.
+	// Use it to tap every event, e.g. otelsink.Tap.
+	ConfigureWriter func(w io.Writer) io.Writer
+
+	// tuiOut, when set, receives this config's TUI helper output in place of
+	// the package-level TUI output. TUI.Render sets it on the snapshot it
+	// passes to components; Configure clears it, so it never becomes global.
+	tuiOut io.Writer
 }
 
 // LogFile is a named log file destination used by WriteFile.
@@ -55,6 +134,21 @@ type Config struct {
 type LogFile struct {
 	Name string `toml:"name"`
 	Path string `toml:"path"`
+	// MaxBackups caps the rotated backups (<name>.1<ext>, <name>.2<ext>, …)
+	// kept on rotation; older ones are deleted. Zero keeps all backups.
+	MaxBackups int `toml:"max_backups"`
+	// MaxSize rotates the file before a write would grow it past this many
+	// bytes. Zero disables size-based rotation.
+	MaxSize int64 `toml:"max_size"`
+	// MaxAge deletes backups last modified longer ago than this on rotation.
+	// Zero keeps backups regardless of age. In TOML use a duration string
+	// such as "168h".
+	MaxAge time.Duration `toml:"max_age"`
+	// Compress gzips backups older than index 1 after rotation
+	// (<name>.2<ext>.gz, …). Backup 1 stays plain.
+	Compress bool `toml:"compress"`
+	// CompressLevel is the gzip level (1–9). Zero uses gzip.DefaultCompression.
+	CompressLevel int `toml:"compress_level"`
 }
 
 // LogFunc is a deferred log write parameterized over a Logger.
@@ -110,10 +204,13 @@ var (
 
 	// filesMu guards openFiles.
 	filesMu   sync.RWMutex
-	openFiles = make(map[string]*os.File)
+	openFiles = make(map[string]*RotatingFileWriter)
 )
 
-const defaultConfigFile = "smplog.config.toml"
+const (
+	defaultConfigFile       = "smplog.config.toml"
+	defaultTruncationMarker = "…"
+)
 
 func init() {
 	cfg, err := ConfigFromFile(defaultConfigFile)
@@ -156,9 +253,9 @@ func applyFiles(files []LogFile) {
 	for _, f := range openFiles {
 		f.Close()
 	}
-	openFiles = make(map[string]*os.File)
+	openFiles = make(map[string]*RotatingFileWriter)
 	for _, lf := range files {
-		f, err := os.OpenFile(lf.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		f, err := NewRotatingFileWriter(lf)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "smplog: open log file %q (%s): %v\n", lf.Name, lf.Path, err)
 			continue
@@ -174,6 +271,21 @@ func Configured() Config {
 	return currentConfig
 }
 
+// setTUIMaxWidth updates TUI.MaxWidth in place. The TUI config does not affect
+// the logger, so there is no need to rebuild it or reopen file sinks.
+func setTUIMaxWidth(width int) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	currentConfig.TUI.MaxWidth = max(width, 0)
+}
+
+// setTUILeftMargin updates TUI.LeftMargin in place, like setTUIMaxWidth.
+func setTUILeftMargin(margin int) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	currentConfig.TUI.LeftMargin = max(margin, 0)
+}
+
 // SetBypass toggles bypass (JSON) mode without rebuilding the full config.
 func SetBypass(enabled bool) {
 	cfg := Configured()
@@ -195,6 +307,41 @@ func SetLevel(level Level) {
 	Configure(cfg)
 }
 
+// SetOutput redirects output to w, keeping the rest of the active config.
+func SetOutput(w io.Writer) {
+	cfg := Configured()
+	cfg.Writer = w
+	Configure(cfg)
+}
+
+// SetTimeFormat updates the console-mode timestamp layout.
+func SetTimeFormat(format string) {
+	cfg := Configured()
+	cfg.TimeFormat = format
+	Configure(cfg)
+}
+
+// SetCaller toggles the caller field.
+func SetCaller(enabled bool) {
+	cfg := Configured()
+	cfg.Caller = enabled
+	Configure(cfg)
+}
+
+// SetTimestamp toggles the timestamp field.
+func SetTimestamp(enabled bool) {
+	cfg := Configured()
+	cfg.Timestamp = enabled
+	Configure(cfg)
+}
+
+// SetNoColor toggles ANSI colors in console mode.
+func SetNoColor(enabled bool) {
+	cfg := Configured()
+	cfg.NoColor = enabled
+	Configure(cfg)
+}
+
 // SetLogger replaces the package-global logger directly, bypassing Configure.
 func SetLogger(l Logger) {
 	stateMu.Lock()
@@ -214,6 +361,35 @@ func With() Context {
 	return Zerolog().With()
 }
 
+// WithRequestID returns a child of the active logger with a "request_id" field.
+func WithRequestID(requestID string) Logger {
+	return Zerolog().With().Str("request_id", requestID).Logger()
+}
+
+// WithTraceID returns a child of the active logger with "trace_id" and "span_id" fields.
+func WithTraceID(traceID, spanID string) Logger {
+	return Zerolog().With().Str("trace_id", traceID).Str("span_id", spanID).Logger()
+}
+
+// SetRequestID adds a "request_id" field to the package-global logger in place.
+// The field lasts until the next Configure; calling it again appends another
+// request_id field rather than replacing the first.
+func SetRequestID(requestID string) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	l := currentLogger.With().Str("request_id", requestID).Logger()
+	currentLogger = &l
+}
+
+// SetTraceID adds "trace_id" and "span_id" fields to the package-global logger
+// in place. Like SetRequestID, the fields last until the next Configure.
+func SetTraceID(traceID, spanID string) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	l := currentLogger.With().Str("trace_id", traceID).Str("span_id", spanID).Logger()
+	currentLogger = &l
+}
+
 // AtLevel returns a level-scoped event from the active logger.
 func AtLevel(level Level) *Event {
 	return Zerolog().WithLevel(zerolog.Level(level))
@@ -221,6 +397,7 @@ func AtLevel(level Level) *Event {
 
 // normalizeConfig replaces zero-value fields with defaults.
 func normalizeConfig(cfg Config) Config {
+	cfg.tuiOut = nil // render-scoped; see TUI.Render
 	if cfg.Writer == nil {
 		cfg.Writer = os.Stdout
 	}
@@ -230,6 +407,21 @@ func normalizeConfig(cfg Config) Config {
 	if cfg.Colors == (ConsoleColors{}) {
 		cfg.Colors = DefaultColors()
 	}
+	if cfg.TruncationMarker == "" {
+		cfg.TruncationMarker = defaultTruncationMarker
+	}
+	if cfg.SelectMaxRetries <= 0 {
+		cfg.SelectMaxRetries = defaultSelectMaxRetries
+	}
+	if cfg.DedupCacheSize <= 0 {
+		cfg.DedupCacheSize = defaultDedupCacheSize
+	}
+	if cfg.HTTPSinkTimeout <= 0 {
+		cfg.HTTPSinkTimeout = defaultHTTPSinkTimeout
+	}
+	if cfg.HTTPSinkRetries == 0 {
+		cfg.HTTPSinkRetries = defaultHTTPSinkRetries
+	}
 	cfg.TUI = normalizeTUIConfig(cfg.TUI)
 	return cfg
 }
@@ -245,17 +437,18 @@ func buildLogger(cfg Config) Logger {
 
 	writer := cfg.Writer
 	if !cfg.Bypass {
-		console := ConsoleWriter{
-			Out:        cfg.Writer,
-			NoColor:    cfg.NoColor,
-			TimeFormat: cfg.TimeFormat,
-		}
-		applyConsoleFormatting(&console, cfg)
-		if cfg.ConfigureConsole != nil {
-			cfg.ConfigureConsole(&console)
-		}
-		writer = console
+		writer = buildConsoleWriter(cfg)
 	}
+	if cfg.ConfigureWriter != nil {
+		writer = cfg.ConfigureWriter(writer)
+	}
+	if fn := jsonRewrites(cfg); fn != nil {
+		writer = jsonLineWriter{w: writer, fn: fn}
+	}
+	if cfg.SamplingRate > 0 && cfg.SamplingRate < 1 {
+		writer = NewSamplingWriter(writer, cfg.SamplingRate, cfg.SamplingRng)
+	}
+	writer = metricsWriter{w: writer}
 
 	logger := zerolog.New(writer).Level(cfg.Level)
 	ctx := logger.With()
@@ -268,7 +461,25 @@ func buildLogger(cfg Config) Logger {
 	if cfg.Stack {
 		ctx = ctx.Stack()
 	}
+	for _, key := range slices.Sorted(maps.Keys(cfg.AlwaysFields)) {
+		ctx = appendField(ctx, key, cfg.AlwaysFields[key])
+	}
+	if cfg.IncludeProcessInfo {
+		ctx = ctx.Int("pid", os.Getpid())
+		if host, err := os.Hostname(); err == nil {
+			ctx = ctx.Str("hostname", host)
+		}
+	}
+	if cfg.IncludeCommit != "" {
+		ctx = ctx.Str("commit", cfg.IncludeCommit)
+	}
 	logger = ctx.Logger()
+	if cfg.IncludeGoroutineID {
+		logger = logger.Hook(NewFieldHook("goroutine", func() any { return int64(goroutineID()) }))
+	}
+	for _, h := range cfg.Hooks {
+		logger = logger.Hook(h)
+	}
 
 	if cfg.ConfigureLogger != nil {
 		logger = cfg.ConfigureLogger(logger)
@@ -277,6 +488,100 @@ func buildLogger(cfg Config) Logger {
 	return logger
 }
 
+// buildConsoleWriter returns the ConsoleWriter buildLogger uses in console
+// mode: cfg's colors and formatting applied, then cfg.ConfigureConsole.
+func buildConsoleWriter(cfg Config) ConsoleWriter {
+	console := ConsoleWriter{
+		Out:        cfg.Writer,
+		NoColor:    cfg.NoColor,
+		TimeFormat: cfg.TimeFormat,
+	}
+	applyConsoleFormatting(&console, cfg)
+	if cfg.ConfigureConsole != nil {
+		cfg.ConfigureConsole(&console)
+	}
+	return console
+}
+
+// Console returns a ConsoleWriter formatted per the active Config, for use in
+// custom writer setups such as MultiLevelWriter. It writes to Config.Writer;
+// change Out to redirect it.
+func Console() ConsoleWriter {
+	return buildConsoleWriter(Configured())
+}
+
+// ConsoleAt is Console for an explicit config. Zero-value fields take their
+// defaults as in Configure.
+func ConsoleAt(cfg Config) ConsoleWriter {
+	return buildConsoleWriter(normalizeConfig(cfg))
+}
+
+// jsonRewrites composes the JSON line rewrites enabled by cfg, or returns
+// nil when output passes through untouched. Rewrites run on zerolog's JSON
+// before it reaches the ConsoleWriter (console mode) or cfg.Writer (bypass).
+func jsonRewrites(cfg Config) func([]jsonField) []jsonField {
+	var fns []func([]jsonField) []jsonField
+	if cfg.TimestampFunc != nil {
+		fns = append(fns, rewriteTimestampField(cfg.TimestampFunc))
+	}
+	if len(cfg.RedactKeys) > 0 {
+		fns = append(fns, redactFields(cfg.RedactKeys))
+	}
+	if len(cfg.MaskRules) > 0 {
+		fns = append(fns, maskFields(cfg.MaskRules))
+	}
+	if cfg.Bypass && cfg.MaxMessageLength > 0 {
+		fns = append(fns, truncateMessageField(cfg.MaxMessageLength, cfg.TruncationMarker))
+	}
+	if cfg.Bypass && len(cfg.FieldNameMap) > 0 {
+		fns = append(fns, renameFields(cfg.FieldNameMap))
+	}
+	if cfg.Bypass && len(cfg.FieldOrder) > 0 {
+		fns = append(fns, orderFields(cfg.FieldOrder))
+	}
+	if len(fns) == 0 {
+		return nil
+	}
+	return func(fields []jsonField) []jsonField {
+		for _, fn := range fns {
+			fields = fn(fields)
+		}
+		return fields
+	}
+}
+
+// renameConsoleFields applies Config.FieldNameMap to a console event. The
+// timestamp, level, caller and message keys are left alone because
+// ConsoleWriter renders them as unnamed columns looked up by key.
+func renameConsoleFields(evt map[string]any, names map[string]string) {
+	moved := make(map[string]any)
+	for from, to := range names {
+		switch from {
+		case zerolog.TimestampFieldName, zerolog.LevelFieldName, zerolog.CallerFieldName, zerolog.MessageFieldName:
+			continue
+		}
+		if v, ok := evt[from]; ok {
+			moved[to] = v
+			delete(evt, from)
+		}
+	}
+	for k, v := range moved {
+		evt[k] = v
+	}
+}
+
+// truncateMessage clips msg to limit runes, ending in marker when clipped.
+func truncateMessage(msg string, limit int, marker string) string {
+	if limit <= 0 || utf8.RuneCountInString(msg) <= limit {
+		return msg
+	}
+	keep := limit - utf8.RuneCountInString(marker)
+	if keep <= 0 {
+		return Clip(limit, marker)
+	}
+	return Clip(keep, msg) + marker
+}
+
 // applyConsoleFormatting wires ANSI color transforms onto the ConsoleWriter.
 func applyConsoleFormatting(console *ConsoleWriter, cfg Config) {
 	console.FormatPrepare = func(evt map[string]any) error {
@@ -295,10 +600,11 @@ func applyConsoleFormatting(console *ConsoleWriter, cfg Config) {
 			}
 			evt[zerolog.MessageFieldName] = colorize(
 				msgColor,
-				fmt.Sprint(raw),
+				truncateMessage(fmt.Sprint(raw), cfg.MaxMessageLength, cfg.TruncationMarker),
 				cfg.NoColor,
 			)
 		}
+		renameConsoleFields(evt, cfg.FieldNameMap)
 		return nil
 	}
 	console.FormatTimestamp = func(i any) string {
@@ -332,6 +638,32 @@ func Debug(msg string) { Zerolog().Debug().Msg(msg) }
 // Debugf logs a formatted message at debug level.
 func Debugf(format string, v ...any) { Zerolog().Debug().Msgf(format, v...) }
 
+// TraceStringer logs s.String() at trace level, calling String only when
+// IsTraceEnabled reports true.
+func TraceStringer(s fmt.Stringer) {
+	if IsTraceEnabled() {
+		Zerolog().Trace().Msg(s.String())
+	}
+}
+
+// DebugStringer logs s.String() at debug level, calling String only when
+// IsDebugEnabled reports true, so suppressed calls do no formatting:
+//
+//	logs.DebugStringer(state) // state.String() runs only at debug level
+func DebugStringer(s fmt.Stringer) {
+	if IsDebugEnabled() {
+		Zerolog().Debug().Msg(s.String())
+	}
+}
+
+// InfoStringer logs s.String() at info level, calling String only when
+// IsInfoEnabled reports true.
+func InfoStringer(s fmt.Stringer) {
+	if IsInfoEnabled() {
+		Zerolog().Info().Msg(s.String())
+	}
+}
+
 // Info logs a message at info level.
 func Info(msg string) { Zerolog().Info().Msg(msg) }
 
@@ -352,6 +684,39 @@ func Error(err error, msg string) { Zerolog().Error().Err(err).Msg(msg) }
 // If err is nil zerolog omits the error field.
 func Errorf(err error, format string, v ...any) { Zerolog().Error().Err(err).Msgf(format, v...) }
 
+// Debugv logs at debug level with slog-style arguments; see Infov.
+func Debugv(args ...any) { logv(Zerolog().Debug(), args) }
+
+// Infov logs at info level with slog-style arguments: alternating field
+// keys and values, with a final unpaired argument as the message.
+// Values use the same typed encoding as WithFields:
+//
+//	logs.Infov("user", "bob", "items", 3, "checkout done")
+//	// {"level":"info","user":"bob","items":3,"message":"checkout done"}
+func Infov(args ...any) { logv(Zerolog().Info(), args) }
+
+// Warnv logs at warn level with slog-style arguments; see Infov.
+func Warnv(args ...any) { logv(Zerolog().Warn(), args) }
+
+// Errorv logs at error level with slog-style arguments; see Infov.
+func Errorv(args ...any) { logv(Zerolog().Error(), args) }
+
+// logv writes args to e as key/value fields plus an optional trailing
+// message. A nil e (disabled level) is ignored.
+func logv(e *Event, args []any) {
+	if e == nil {
+		return
+	}
+	for _, f := range fieldPairs(args) {
+		appendEventField(e, f.key, f.value)
+	}
+	msg := ""
+	if len(args)%2 == 1 {
+		msg = fmt.Sprint(args[len(args)-1])
+	}
+	e.Msg(msg)
+}
+
 // Fatal logs a message at fatal level with a structured error field, then exits.
 // If err is nil zerolog omits the error field.
 func Fatal(err error, msg string) { Zerolog().Fatal().Err(err).Msg(msg) }
@@ -359,3 +724,36 @@ func Fatal(err error, msg string) { Zerolog().Fatal().Err(err).Msg(msg) }
 // Fatalf logs a formatted message at fatal level with a structured error field, then exits.
 // If err is nil zerolog omits the error field.
 func Fatalf(err error, format string, v ...any) { Zerolog().Fatal().Err(err).Msgf(format, v...) }
+
+// LogIfError logs msg at error level with err when err is non-nil and reports
+// whether it did:
+//
+//	if logs.LogIfError(err, "save failed") {
+//		return
+//	}
+func LogIfError(err error, msg string) bool { return LogIfErrorAt(ErrorLevel, err, msg) }
+
+// LogIfErrorAt is LogIfError at an explicit level.
+func LogIfErrorAt(level Level, err error, msg string) bool {
+	if err == nil {
+		return false
+	}
+	Zerolog().WithLevel(level).Err(err).Msg(msg)
+	return true
+}
+
+// ReturnIfError logs msg at error level when err is non-nil and returns err
+// unchanged (nil when err is nil):
+//
+//	return logs.ReturnIfError(db.Close(), "close db")
+func ReturnIfError(err error, msg string) error {
+	LogIfError(err, msg)
+	return err
+}
+
+// MustNoError logs msg at fatal level and exits when err is non-nil.
+func MustNoError(err error, msg string) {
+	if err != nil {
+		Fatal(err, msg)
+	}
+}
@@ -4,10 +4,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 )
 
 // TestConfigureAppliesConsoleColors verifies configured level/message colors are emitted.
@@ -91,6 +96,30 @@ func TestConfigureLoggerHookIsApplied(t *testing.T) {
 	}
 }
 
+// TestConfigureWriterSeesJSONInConsoleMode verifies ConfigureWriter wraps the writer ahead of the ConsoleWriter.
+func TestConfigureWriterSeesJSONInConsoleMode(t *testing.T) {
+	var out, tapped bytes.Buffer
+
+	Configure(Config{
+		Writer:  &out,
+		Level:   InfoLevel,
+		NoColor: true,
+		ConfigureWriter: func(w io.Writer) io.Writer {
+			return io.MultiWriter(&tapped, w)
+		},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Info("tap-check")
+
+	if !strings.HasPrefix(tapped.String(), "{") || !strings.Contains(tapped.String(), `"message":"tap-check"`) {
+		t.Fatalf("expected JSON at the tap, got %q", tapped.String())
+	}
+	if strings.HasPrefix(out.String(), "{") || !strings.Contains(out.String(), "tap-check") {
+		t.Fatalf("expected console output, got %q", out.String())
+	}
+}
+
 // TestNoColorSuppressesANSIInConsoleMode verifies NoColor strips ANSI output in console mode.
 func TestNoColorSuppressesANSIInConsoleMode(t *testing.T) {
 	var out bytes.Buffer
@@ -209,3 +238,481 @@ func TestErrorWithNilErrOmitsErrorField(t *testing.T) {
 		t.Fatalf("expected message field in output: %q", logLine)
 	}
 }
+
+// TestFieldOrderReordersBypassJSON verifies FieldOrder keys lead the JSON output in order.
+func TestFieldOrderReordersBypassJSON(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer:     &out,
+		Level:      InfoLevel,
+		Timestamp:  true,
+		Bypass:     true,
+		FieldOrder: []string{"time", "level", "message"},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Str("zone", "b").Str("app", "a").Msg("ordered")
+
+	logLine := strings.TrimSpace(out.String())
+	if !json.Valid([]byte(logLine)) {
+		t.Fatalf("expected valid JSON, got %q", logLine)
+	}
+	idx := func(key string) int { return strings.Index(logLine, `"`+key+`":`) }
+	if !(idx("time") < idx("level") && idx("level") < idx("message") && idx("message") < idx("zone") && idx("zone") < idx("app")) {
+		t.Fatalf("unexpected field order: %q", logLine)
+	}
+}
+
+// levelRecorder is a LevelWriter that records the level of each write.
+type levelRecorder struct {
+	bytes.Buffer
+	levels []Level
+}
+
+func (r *levelRecorder) WriteLevel(level Level, p []byte) (int, error) {
+	r.levels = append(r.levels, level)
+	return r.Write(p)
+}
+
+// TestFieldOrderForwardsWriteLevel verifies the JSON line rewrite keeps LevelWriter destinations working.
+func TestFieldOrderForwardsWriteLevel(t *testing.T) {
+	var out levelRecorder
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, FieldOrder: []string{"message"}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Warn("leveled")
+
+	if len(out.levels) != 1 || out.levels[0] != WarnLevel {
+		t.Fatalf("expected one warn WriteLevel call, got %v", out.levels)
+	}
+	if !strings.HasPrefix(out.String(), `{"message":"leveled"`) {
+		t.Fatalf("expected rewritten line, got %q", out.String())
+	}
+}
+
+// TestFieldNameMapRenamesBypassKeys verifies renamed keys replace the originals and feed FieldOrder.
+func TestFieldNameMapRenamesBypassKeys(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer:       &out,
+		Level:        InfoLevel,
+		Timestamp:    true,
+		Bypass:       true,
+		FieldNameMap: map[string]string{"time": "ts", "level": "lvl"},
+		FieldOrder:   []string{"lvl", "ts"},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Msg("renamed")
+
+	logLine := strings.TrimSpace(out.String())
+	if !strings.HasPrefix(logLine, `{"lvl":"info","ts":`) {
+		t.Fatalf("expected renamed keys first, got %q", logLine)
+	}
+	if strings.Contains(logLine, `"time":`) || strings.Contains(logLine, `"level":`) {
+		t.Fatalf("expected original keys removed, got %q", logLine)
+	}
+}
+
+// TestFieldNameMapRenamesConsoleFields verifies console mode renames fields but keeps the columns.
+func TestFieldNameMapRenamesConsoleFields(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer:       &out,
+		Level:        InfoLevel,
+		NoColor:      true,
+		FieldNameMap: map[string]string{"level": "lvl", "user": "u"},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Str("user", "bob").Msg("hello")
+
+	plain := strings.TrimSpace(out.String())
+	if !strings.HasPrefix(plain, "INFO hello") || !strings.HasSuffix(plain, "ubob") || strings.Contains(plain, "user") {
+		t.Fatalf("unexpected console line %q", plain)
+	}
+}
+
+// TestAlwaysFieldsAppearInEveryEvent verifies static fields are added to each line.
+func TestAlwaysFieldsAppearInEveryEvent(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer:       &out,
+		Level:        InfoLevel,
+		Bypass:       true,
+		AlwaysFields: map[string]any{"service": "api", "shard": 3},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Msg("one")
+	Zerolog().Warn().Str("extra", "x").Msg("two")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if line["service"] != "api" || line["shard"] != float64(3) {
+			t.Fatalf("expected always fields in %v", line)
+		}
+	}
+}
+
+// TestMaxMessageLengthTruncatesConsoleMessage verifies long messages are clipped to the visible limit.
+func TestMaxMessageLengthTruncatesConsoleMessage(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer:           &out,
+		Level:            InfoLevel,
+		Timestamp:        false,
+		Bypass:           false,
+		NoColor:          false,
+		MaxMessageLength: 100,
+		Colors:           ConsoleColors{Info: StyleColor256(4)},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Info(strings.Repeat("x", 500))
+
+	plain := strings.TrimSpace(StripANSI(out.String()))
+	msg := strings.TrimPrefix(plain, "INFO ")
+	if got := utf8.RuneCountInString(msg); got != 100 {
+		t.Fatalf("expected 100 visible message runes, got %d: %q", got, plain)
+	}
+	if !strings.HasSuffix(msg, "…") {
+		t.Fatalf("expected truncation marker suffix: %q", msg)
+	}
+}
+
+// TestMaxMessageLengthTruncatesBypassMessage verifies bypass JSON messages are clipped too.
+func TestMaxMessageLengthTruncatesBypassMessage(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer:           &out,
+		Level:            InfoLevel,
+		Bypass:           true,
+		MaxMessageLength: 10,
+		TruncationMarker: "...",
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Info("abcdefghijklmnop")
+
+	logLine := strings.TrimSpace(out.String())
+	if !strings.Contains(logLine, `"message":"abcdefg..."`) {
+		t.Fatalf("expected truncated message in output: %q", logLine)
+	}
+}
+
+// TestTimestampFuncOverridesTimestampField verifies a fixed clock produces an exact timestamp.
+func TestTimestampFuncOverridesTimestampField(t *testing.T) {
+	var out bytes.Buffer
+	fixed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	Configure(Config{
+		Writer:        &out,
+		Level:         InfoLevel,
+		Timestamp:     true,
+		Bypass:        true,
+		TimestampFunc: func() time.Time { return fixed },
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Info("clock-check")
+
+	logLine := strings.TrimSpace(out.String())
+	if !strings.Contains(logLine, `"time":"2024-01-02T03:04:05Z"`) {
+		t.Fatalf("expected fixed timestamp in output: %q", logLine)
+	}
+}
+
+// TestRequestAndTraceIDFields verifies tracing shorthands emit the expected JSON fields.
+func TestRequestAndTraceIDFields(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{
+		Writer: &out,
+		Level:  InfoLevel,
+		Bypass: true,
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := WithRequestID("req-1")
+	l.Info().Msg("child")
+	tl := WithTraceID("trace-1", "span-1")
+	tl.Info().Msg("child")
+	SetRequestID("req-2")
+	SetTraceID("trace-2", "span-2")
+	Info("global")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 log lines, got %d: %q", len(lines), out.String())
+	}
+	if !strings.Contains(lines[0], `"request_id":"req-1"`) {
+		t.Errorf("expected request_id in line 0: %q", lines[0])
+	}
+	if !strings.Contains(lines[1], `"trace_id":"trace-1"`) || !strings.Contains(lines[1], `"span_id":"span-1"`) {
+		t.Errorf("expected trace_id/span_id in line 1: %q", lines[1])
+	}
+	for _, want := range []string{`"request_id":"req-2"`, `"trace_id":"trace-2"`, `"span_id":"span-2"`} {
+		if !strings.Contains(lines[2], want) {
+			t.Errorf("expected %s in global line: %q", want, lines[2])
+		}
+	}
+}
+
+// TestLogIfErrorHelpers verifies the helpers log only non-nil errors and report them.
+func TestLogIfErrorHelpers(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	err := errors.New("disk full")
+	if LogIfError(nil, "unused") || LogIfErrorAt(WarnLevel, nil, "unused") || ReturnIfError(nil, "unused") != nil {
+		t.Fatal("expected nil errors to be ignored")
+	}
+	if out.Len() != 0 {
+		t.Fatalf("expected no output for nil errors, got %q", out.String())
+	}
+
+	if !LogIfError(err, "save failed") {
+		t.Fatal("expected LogIfError to report a non-nil error")
+	}
+	if !LogIfErrorAt(WarnLevel, err, "retrying") {
+		t.Fatal("expected LogIfErrorAt to report a non-nil error")
+	}
+	if got := ReturnIfError(err, "giving up"); got != err {
+		t.Fatalf("expected ReturnIfError to return err, got %v", got)
+	}
+
+	want := `{"level":"error","error":"disk full","message":"save failed"}` + "\n" +
+		`{"level":"warn","error":"disk full","message":"retrying"}` + "\n" +
+		`{"level":"error","error":"disk full","message":"giving up"}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output: %q", out.String())
+	}
+}
+
+// TestConsoleAtMatchesConfiguredFormatting verifies ConsoleAt formats like a configured logger.
+func TestConsoleAtMatchesConfiguredFormatting(t *testing.T) {
+	var viaLogger, viaConsole bytes.Buffer
+	cfg := Config{Writer: &viaLogger, Level: InfoLevel, NoColor: true}
+
+	Configure(cfg)
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	Info("hello")
+
+	cfg.Writer = &viaConsole
+	l := New(ConsoleAt(cfg))
+	l.Info().Msg("hello")
+
+	if viaConsole.String() != viaLogger.String() {
+		t.Fatalf("expected identical console output, got %q vs %q", viaConsole.String(), viaLogger.String())
+	}
+
+	var out bytes.Buffer
+	console := Console()
+	console.Out = &out
+	logger := New(console)
+	logger.Info().Msg("hello")
+	if out.String() != viaLogger.String() {
+		t.Fatalf("expected Console to use active config, got %q vs %q", out.String(), viaLogger.String())
+	}
+}
+
+// TestSingleFieldSettersKeepOtherFields verifies SetOutput and friends change only their field.
+func TestSingleFieldSettersKeepOtherFields(t *testing.T) {
+	Configure(Config{Writer: &bytes.Buffer{}, Level: WarnLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var out bytes.Buffer
+	SetOutput(&out)
+	SetTimeFormat("15:04")
+	SetCaller(true)
+	SetTimestamp(true)
+	SetNoColor(true)
+
+	cfg := Configured()
+	if cfg.Writer != &out || cfg.TimeFormat != "15:04" || !cfg.Caller || !cfg.Timestamp || !cfg.NoColor {
+		t.Fatalf("expected setters applied, got %+v", cfg)
+	}
+	if cfg.Level != WarnLevel || !cfg.Bypass {
+		t.Fatalf("expected other fields kept, got level=%v bypass=%v", cfg.Level, cfg.Bypass)
+	}
+
+	Zerolog().Warn().Msg("redirected")
+	lines := decodeLines(t, out.String())
+	if len(lines) != 1 || lines[0]["caller"] == nil || lines[0]["time"] == nil {
+		t.Fatalf("expected output with caller and time on the new writer, got %v", lines)
+	}
+}
+
+// TestIncludeProcessInfoAndCommit verifies pid, hostname and commit context fields in bypass mode.
+func TestIncludeProcessInfoAndCommit(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, IncludeProcessInfo: true, IncludeCommit: "abc1234"})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Msg("hello")
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	Zerolog().Info().Msg("plain")
+
+	lines := decodeLines(t, out.String())
+	if lines[0]["pid"] != float64(os.Getpid()) {
+		t.Fatalf("expected pid %d, got %v", os.Getpid(), lines[0]["pid"])
+	}
+	if host, err := os.Hostname(); err == nil && lines[0]["hostname"] != host {
+		t.Fatalf("expected hostname %q, got %v", host, lines[0]["hostname"])
+	}
+	if lines[0]["commit"] != "abc1234" {
+		t.Fatalf("expected commit field, got %v", lines[0]["commit"])
+	}
+	for _, key := range []string{"pid", "hostname", "commit"} {
+		if _, ok := lines[1][key]; ok {
+			t.Fatalf("expected no %s field when disabled", key)
+		}
+	}
+}
+
+type testPoint struct{ x, y int }
+
+func (p testPoint) String() string { return fmt.Sprintf("(%d,%d)", p.x, p.y) }
+
+func (p testPoint) MarshalZerologObject(e *Event) { e.Int("x", p.x).Int("y", p.y) }
+
+// TestEventShorthandsStringerDictObjectRawJSON verifies the single-call field helpers emit info events.
+func TestEventShorthandsStringerDictObjectRawJSON(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Stringer("at", testPoint{1, 2}).Msg("stringer")
+	DictObject("pt", testPoint{3, 4}).Msg("object")
+	RawJSON("raw", []byte(`{"a":[1,2]}`)).Msg("raw")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if line["level"] != "info" {
+			t.Fatalf("expected info level, got %v", line["level"])
+		}
+	}
+	if lines[0]["at"] != "(1,2)" {
+		t.Fatalf("unexpected stringer field %v", lines[0]["at"])
+	}
+	if pt, _ := lines[1]["pt"].(map[string]any); pt["x"] != float64(3) || pt["y"] != float64(4) {
+		t.Fatalf("unexpected object field %v", lines[1]["pt"])
+	}
+	if raw, _ := lines[2]["raw"].(map[string]any); raw == nil || len(raw["a"].([]any)) != 2 {
+		t.Fatalf("unexpected raw field %v", lines[2]["raw"])
+	}
+}
+
+type countingStringer struct{ calls int }
+
+func (s *countingStringer) String() string {
+	s.calls++
+	return "state " + strconv.Itoa(s.calls)
+}
+
+// TestLevelStringersCallStringOnlyWhenEnabled verifies String runs only for enabled levels.
+func TestLevelStringersCallStringOnlyWhenEnabled(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	s := &countingStringer{}
+	TraceStringer(s)
+	DebugStringer(s)
+	if s.calls != 0 {
+		t.Fatalf("expected String not called for suppressed levels, called %d times", s.calls)
+	}
+	InfoStringer(s)
+	if s.calls != 1 || out.String() != `{"level":"info","message":"state 1"}`+"\n" {
+		t.Fatalf("unexpected output %q after %d calls", out.String(), s.calls)
+	}
+
+	out.Reset()
+	Configure(Config{Writer: &out, Level: TraceLevel, Bypass: true})
+	TraceStringer(s)
+	DebugStringer(s)
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 || lines[0]["level"] != "trace" || lines[1]["message"] != "state 3" {
+		t.Fatalf("unexpected lines %v", lines)
+	}
+}
+
+// benchStringer allocates exactly once per String call.
+type benchStringer []byte
+
+func (s benchStringer) String() string { return string(s) }
+
+// BenchmarkDebugStringerSuppressed measures DebugStringer below the configured level (0 allocs).
+func BenchmarkDebugStringerSuppressed(b *testing.B) {
+	Configure(Config{Writer: io.Discard, Level: InfoLevel, Bypass: true})
+	b.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var s fmt.Stringer = benchStringer("connection pool state")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DebugStringer(s)
+	}
+}
+
+// BenchmarkDebugStringerEnabled measures DebugStringer at debug level (String's allocation only).
+func BenchmarkDebugStringerEnabled(b *testing.B) {
+	Configure(Config{Writer: io.Discard, Level: DebugLevel, Bypass: true})
+	b.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var s fmt.Stringer = benchStringer("connection pool state")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DebugStringer(s)
+	}
+}
+
+// TestLevelvFunctionsTakeFieldsAndMessage verifies slog-style key/value args with a trailing message.
+func TestLevelvFunctionsTakeFieldsAndMessage(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Infov("key", "val", "msg")
+	if want := `{"level":"info","key":"val","message":"msg"}` + "\n"; out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+
+	out.Reset()
+	Warnv("n", 3, "ok", true, "d", 2*time.Second)
+	Errorv("err", errors.New("boom"), "failed")
+	Debugv("hidden", 1, "suppressed")
+	Infov()
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d: %q", len(lines), out.String())
+	}
+	if lines[0]["level"] != "warn" || lines[0]["n"] != float64(3) || lines[0]["ok"] != true ||
+		lines[0]["d"] != float64(2000) || lines[0]["message"] != nil {
+		t.Fatalf("unexpected warn line %v", lines[0])
+	}
+	if lines[1]["level"] != "error" || lines[1]["err"] != "boom" || lines[1]["message"] != "failed" {
+		t.Fatalf("unexpected error line %v", lines[1])
+	}
+	if lines[2]["level"] != "info" || len(lines[2]) != 1 {
+		t.Fatalf("unexpected empty info line %v", lines[2])
+	}
+}
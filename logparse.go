@@ -0,0 +1,206 @@
+package logs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// ParseLogLines decodes newline-delimited JSON log output, such as
+// ReplayBuffer contents, into one map per non-empty line. On a decode error
+// it returns the lines decoded so far and the error.
+//
+//	lines, err := logs.ParseLogLines([]byte(rb.ReplayString()))
+func ParseLogLines(data []byte) ([]map[string]any, error) {
+	var out []map[string]any
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var m map[string]any
+		if err := json.Unmarshal(line, &m); err != nil {
+			return out, fmt.Errorf("smplog: parse log line %d: %w", i+1, err)
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// FilterLogLines returns the entries whose level field is level or more
+// severe. Entries without a recognizable level are dropped.
+func FilterLogLines(lines []map[string]any, level Level) []map[string]any {
+	var out []map[string]any
+	for _, line := range lines {
+		s, ok := line[zerolog.LevelFieldName].(string)
+		if !ok {
+			continue
+		}
+		l, err := zerolog.ParseLevel(s)
+		if err != nil || l == NoLevel || l < level {
+			continue
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
+// LogRecord is one parsed log line.
+type LogRecord struct {
+	Level   Level
+	Time    time.Time
+	Message string
+	// Fields holds every other field, decoded as by encoding/json. It is nil
+	// when the line has none.
+	Fields map[string]any
+}
+
+// consolePattern matches a console line (ANSI already stripped): an
+// optional timestamp, the level as written by smplog ("INFO") or by a
+// plain ConsoleWriter ("INF"), then the message and fields.
+var consolePattern = regexp.MustCompile(`^(?:(.*?) )?(TRACE|DEBUG|INFO|WARN|ERROR|FATAL|PANIC|TRC|DBG|INF|WRN|ERR|FTL|PNC|\?\?\?)(?: (.*))?$`)
+
+// consoleFieldPattern matches a trailing key=value console field, with the
+// value bare or double-quoted.
+var consoleFieldPattern = regexp.MustCompile(`\s*([^\s=]+)=("(?:[^"\\]|\\.)*"|\S*)$`)
+
+var consoleLevels = map[string]Level{
+	"TRACE": TraceLevel, "TRC": TraceLevel,
+	"DEBUG": DebugLevel, "DBG": DebugLevel,
+	"INFO": InfoLevel, "INF": InfoLevel,
+	"WARN": WarnLevel, "WRN": WarnLevel,
+	"ERROR": ErrorLevel, "ERR": ErrorLevel,
+	"FATAL": FatalLevel, "FTL": FatalLevel,
+	"PANIC": PanicLevel, "PNC": PanicLevel,
+	"???": NoLevel,
+}
+
+// ParseLogLine parses one line of bypass-mode JSON or console output.
+// Lines starting with '{' are decoded as JSON, with the time field read
+// per zerolog.TimeFieldFormat. Other lines are matched as console output
+// after StripANSI: the timestamp is parsed with Config.TimeFormat (falling
+// back to RFC 3339), and trailing key=value pairs become Fields. smplog's
+// own console formatting writes fields without "=", so for it the rest of
+// the line is kept as Message.
+func ParseLogLine(line string) (LogRecord, error) {
+	rec, err := parseLogLine(line)
+	if err != nil {
+		return rec, fmt.Errorf("smplog: parse log line: %w", err)
+	}
+	return rec, nil
+}
+
+func parseLogLine(line string) (LogRecord, error) {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "{") {
+		return parseJSONRecord(line)
+	}
+	m := consolePattern.FindStringSubmatch(StripANSI(line))
+	if m == nil {
+		return LogRecord{}, fmt.Errorf("unrecognized format %q", line)
+	}
+	rec := LogRecord{Level: consoleLevels[m[2]]}
+	if m[1] != "" {
+		for _, layout := range []string{Configured().TimeFormat, time.RFC3339} {
+			if t, err := time.Parse(layout, m[1]); err == nil {
+				rec.Time = t
+				break
+			}
+		}
+	}
+	rest := m[3]
+	for {
+		f := consoleFieldPattern.FindStringSubmatchIndex(rest)
+		if f == nil {
+			break
+		}
+		key, value := rest[f[2]:f[3]], rest[f[4]:f[5]]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		if rec.Fields == nil {
+			rec.Fields = make(map[string]any)
+		}
+		rec.Fields[key] = value
+		rest = rest[:f[0]]
+	}
+	rec.Message = rest
+	return rec, nil
+}
+
+func parseJSONRecord(line string) (LogRecord, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(line), &raw); err != nil {
+		return LogRecord{}, err
+	}
+	rec := LogRecord{Level: NoLevel}
+	for key, value := range raw {
+		switch key {
+		case zerolog.LevelFieldName:
+			var s string
+			if json.Unmarshal(value, &s) == nil {
+				if l, err := zerolog.ParseLevel(s); err == nil {
+					rec.Level = l
+				}
+			}
+		case zerolog.TimestampFieldName:
+			rec.Time, _ = parseTimestampField(value)
+		case zerolog.MessageFieldName:
+			_ = json.Unmarshal(value, &rec.Message)
+		default:
+			var v any
+			_ = json.Unmarshal(value, &v)
+			if rec.Fields == nil {
+				rec.Fields = make(map[string]any)
+			}
+			rec.Fields[key] = v
+		}
+	}
+	return rec, nil
+}
+
+// ParseLogRecords parses every non-empty line read from r with
+// ParseLogLine. On an error it returns the records parsed so far and the
+// error, annotated with the line number.
+func ParseLogRecords(r io.Reader) ([]LogRecord, error) {
+	var out []LogRecord
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		rec, err := parseLogLine(line)
+		if err != nil {
+			return out, fmt.Errorf("smplog: parse log line %d: %w", n, err)
+		}
+		out = append(out, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return out, fmt.Errorf("smplog: read log records: %w", err)
+	}
+	return out, nil
+}
+
+// FilterRecords returns the records at level or more severe whose Message
+// contains substr. An empty substr matches every message; records without
+// a level (NoLevel) are dropped.
+func FilterRecords(records []LogRecord, level Level, substr string) []LogRecord {
+	var out []LogRecord
+	for _, rec := range records {
+		if rec.Level == NoLevel || rec.Level < level || !strings.Contains(rec.Message, substr) {
+			continue
+		}
+		out = append(out, rec)
+	}
+	return out
+}
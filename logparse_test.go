@@ -0,0 +1,131 @@
+package logs
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParseAndFilterLogLines verifies lines are decoded and filtered by severity.
+func TestParseAndFilterLogLines(t *testing.T) {
+	rb, _ := NewReplayBuffer(4096)
+
+	Configure(Config{Writer: rb, Level: DebugLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Debug("d")
+	Info("i")
+	Warn("w")
+	Error(nil, "e")
+
+	lines, err := ParseLogLines([]byte(rb.ReplayString()))
+	if err != nil || len(lines) != 4 {
+		t.Fatalf("expected 4 lines, got %d (%v)", len(lines), err)
+	}
+	got := FilterLogLines(lines, WarnLevel)
+	if len(got) != 2 || got[0]["message"] != "w" || got[1]["message"] != "e" {
+		t.Fatalf("unexpected filtered lines: %v", got)
+	}
+
+	partial, err := ParseLogLines([]byte("{\"level\":\"info\"}\n\nnot json\n{}"))
+	if err == nil || len(partial) != 1 {
+		t.Fatalf("expected partial result and error, got %v, %v", partial, err)
+	}
+}
+
+// TestParseLogLineJSONRoundTrip verifies bypass output parses back into a LogRecord.
+func TestParseLogLineJSONRoundTrip(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, Timestamp: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	before := time.Now().Truncate(time.Second)
+	Zerolog().Warn().Str("user", "bob").Int("n", 3).Msg("disk low")
+
+	rec, err := ParseLogLine(out.String())
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if rec.Level != WarnLevel || rec.Message != "disk low" {
+		t.Fatalf("unexpected record %+v", rec)
+	}
+	if rec.Time.Before(before) || rec.Time.After(time.Now()) {
+		t.Fatalf("unexpected time %v", rec.Time)
+	}
+	want := map[string]any{"user": "bob", "n": float64(3)}
+	if !reflect.DeepEqual(rec.Fields, want) {
+		t.Fatalf("fields: got %v, want %v", rec.Fields, want)
+	}
+
+	if _, err := ParseLogLine(`{"level":`); err == nil || !strings.HasPrefix(err.Error(), "smplog: ") {
+		t.Fatalf("expected prefixed error, got %v", err)
+	}
+}
+
+// TestParseLogLineConsoleRoundTrip verifies console output parses back, with and without colors.
+func TestParseLogLineConsoleRoundTrip(t *testing.T) {
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	for _, noColor := range []bool{true, false} {
+		var out bytes.Buffer
+		Configure(Config{Writer: &out, Level: InfoLevel, Timestamp: true, NoColor: noColor, TimeFormat: time.RFC3339})
+		before := time.Now().Truncate(time.Second)
+		Zerolog().Error().Msg("request failed")
+
+		rec, err := ParseLogLine(out.String())
+		if err != nil {
+			t.Fatalf("noColor=%v: parse: %v", noColor, err)
+		}
+		if rec.Level != ErrorLevel || rec.Message != "request failed" || rec.Fields != nil {
+			t.Fatalf("noColor=%v: unexpected record %+v", noColor, rec)
+		}
+		if rec.Time.Before(before) || rec.Time.After(time.Now()) {
+			t.Fatalf("noColor=%v: unexpected time %v", noColor, rec.Time)
+		}
+	}
+
+	rec, err := ParseLogLine(`10:00PM INF served path=/api status=200 agent="curl 8.0"`)
+	if err != nil {
+		t.Fatalf("parse key=value console line: %v", err)
+	}
+	want := map[string]any{"path": "/api", "status": "200", "agent": "curl 8.0"}
+	if rec.Level != InfoLevel || rec.Message != "served" || !reflect.DeepEqual(rec.Fields, want) {
+		t.Fatalf("unexpected record %+v", rec)
+	}
+	if _, err := ParseLogLine("no level here"); err == nil {
+		t.Fatal("expected error for unrecognized line")
+	}
+}
+
+// TestParseLogRecordsAndFilter verifies reading mixed lines and level+substring filtering.
+func TestParseLogRecordsAndFilter(t *testing.T) {
+	input := strings.Join([]string{
+		`{"level":"debug","message":"cache miss"}`,
+		``,
+		`{"level":"warn","message":"cache slow"}`,
+		`2024-01-02T03:04:05Z ERROR cache down`,
+		`{"message":"no level"}`,
+		`{"level":"error","message":"db down"}`,
+	}, "\n")
+	records, err := ParseLogRecords(strings.NewReader(input))
+	if err != nil || len(records) != 5 {
+		t.Fatalf("expected 5 records, got %d (%v)", len(records), err)
+	}
+	if !records[2].Time.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Fatalf("expected console timestamp parsed, got %v", records[2].Time)
+	}
+
+	got := FilterRecords(records, WarnLevel, "cache")
+	if len(got) != 2 || got[0].Message != "cache slow" || got[1].Message != "cache down" {
+		t.Fatalf("unexpected filtered records %+v", got)
+	}
+	if n := len(FilterRecords(records, TraceLevel, "")); n != 4 {
+		t.Fatalf("expected NoLevel record dropped, got %d records", n)
+	}
+
+	partial, err := ParseLogRecords(strings.NewReader("{\"level\":\"info\"}\ngarbage\n"))
+	if err == nil || len(partial) != 1 || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected partial result and line error, got %v, %v", partial, err)
+	}
+}
@@ -0,0 +1,78 @@
+package logs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type lokiPush struct {
+	Streams []struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	} `json:"streams"`
+}
+
+// TestLokiWriterPushesStreamsPerLevel verifies labels, level labels, timestamps and the push path.
+func TestLokiWriterPushesStreamsPerLevel(t *testing.T) {
+	var path string
+	srv := &sinkServer{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		srv.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	w, err := NewLokiWriterWithConfig(ts.URL, map[string]string{"app": "web"}, LokiConfig{BatchSize: 10, FlushInterval: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Write([]byte(`{"level":"info","time":"2024-01-02T03:04:05Z","message":"one"}` + "\n"))
+	w.Write([]byte(`{"level":"error","message":"two"}` + "\n"))
+	w.Write([]byte(`{"level":"info","message":"three"}` + "\n"))
+	w.Close()
+
+	if path != lokiPushPath {
+		t.Fatalf("expected path %q, got %q", lokiPushPath, path)
+	}
+	srv.mu.Lock()
+	bodies := srv.bodies
+	srv.mu.Unlock()
+	if len(bodies) != 1 {
+		t.Fatalf("expected 1 push, got %d", len(bodies))
+	}
+	var push lokiPush
+	if err := json.Unmarshal([]byte(bodies[0]), &push); err != nil {
+		t.Fatalf("decode push: %v", err)
+	}
+	if len(push.Streams) != 2 {
+		t.Fatalf("expected 2 streams, got %+v", push.Streams)
+	}
+	info, errs := push.Streams[0], push.Streams[1]
+	if info.Stream["app"] != "web" || info.Stream["level"] != "info" || errs.Stream["level"] != "error" {
+		t.Fatalf("unexpected labels: %v, %v", info.Stream, errs.Stream)
+	}
+	if len(info.Values) != 2 || len(errs.Values) != 1 {
+		t.Fatalf("unexpected values: %v, %v", info.Values, errs.Values)
+	}
+	want := strconv.FormatInt(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).UnixNano(), 10)
+	if info.Values[0][0] != want {
+		t.Fatalf("expected timestamp %s, got %s", want, info.Values[0][0])
+	}
+	if info.Values[0][1] != `{"level":"info","time":"2024-01-02T03:04:05Z","message":"one"}` {
+		t.Fatalf("unexpected line: %s", info.Values[0][1])
+	}
+}
+
+// TestLokiWriterRejectsBadConfig verifies URL and batching validation.
+func TestLokiWriterRejectsBadConfig(t *testing.T) {
+	if _, err := NewLokiWriter("loki:3100", nil); err == nil {
+		t.Fatal("expected error for url without scheme")
+	}
+	if _, err := NewLokiWriterWithConfig("http://loki:3100", nil, LokiConfig{BatchSize: -1}); err == nil {
+		t.Fatal("expected error for negative batch size")
+	}
+}
@@ -0,0 +1,231 @@
+package logs
+
+import "maps"
+
+// ConfigMask is a bit set of Config fields, used by MergeMasked to tell
+// "explicitly set to the zero value" apart from "not set".
+type ConfigMask uint64
+
+// ConfigMask bits, one per Config field.
+const (
+	MaskWriter ConfigMask = 1 << iota
+	MaskLevel
+	MaskTimestamp
+	MaskCaller
+	MaskStack
+	MaskTimeFormat
+	MaskNoColor
+	MaskBypass
+	MaskFieldOrder
+	MaskColors
+	MaskTUI
+	MaskSelectMaxRetries
+	MaskRequirePassword
+	MaskHTTPLevelMap
+	MaskFiles
+	MaskConfigureZerolog
+	MaskConfigureConsole
+	MaskConfigureLogger
+	MaskConfigureWriter
+	MaskMaxMessageLength
+	MaskTruncationMarker
+	MaskTimestampFunc
+	MaskDedupCacheSize
+	MaskHTTPSinkTimeout
+	MaskHTTPSinkRetries
+	MaskHooks
+	MaskRedactKeys
+	MaskMaskRules
+	MaskFieldNameMap
+	MaskAlwaysFields
+	MaskIncludeGoroutineID
+	MaskIncludeProcessInfo
+	MaskIncludeCommit
+	MaskSamplingRate
+	MaskSamplingRng
+
+	// MaskAll selects every field.
+	MaskAll ConfigMask = 1<<iota - 1
+)
+
+// Has reports whether every bit in m is set.
+func (mask ConfigMask) Has(m ConfigMask) bool {
+	return mask&m == m
+}
+
+// MergeFrom returns a copy of c with every non-zero field of other applied.
+// Zero values (nil Writer, Level 0, false, "", empty Colors/TUI, nil slices,
+// maps and hooks) are treated as unset, so other cannot turn a bool off or
+// select DebugLevel; use MergeMasked for that.
+func (c Config) MergeFrom(other Config) Config {
+	return c.MergeMasked(other, nonZeroMask(other))
+}
+
+// MergeMasked returns a copy of c with the fields selected by mask copied
+// from other, including zero values.
+func (c Config) MergeMasked(other Config, mask ConfigMask) Config {
+	if mask.Has(MaskWriter) {
+		c.Writer = other.Writer
+	}
+	if mask.Has(MaskLevel) {
+		c.Level = other.Level
+	}
+	if mask.Has(MaskTimestamp) {
+		c.Timestamp = other.Timestamp
+	}
+	if mask.Has(MaskCaller) {
+		c.Caller = other.Caller
+	}
+	if mask.Has(MaskStack) {
+		c.Stack = other.Stack
+	}
+	if mask.Has(MaskTimestampFunc) {
+		c.TimestampFunc = other.TimestampFunc
+	}
+	if mask.Has(MaskTimeFormat) {
+		c.TimeFormat = other.TimeFormat
+	}
+	if mask.Has(MaskNoColor) {
+		c.NoColor = other.NoColor
+	}
+	if mask.Has(MaskBypass) {
+		c.Bypass = other.Bypass
+	}
+	if mask.Has(MaskMaxMessageLength) {
+		c.MaxMessageLength = other.MaxMessageLength
+	}
+	if mask.Has(MaskTruncationMarker) {
+		c.TruncationMarker = other.TruncationMarker
+	}
+	if mask.Has(MaskFieldOrder) {
+		c.FieldOrder = other.FieldOrder
+	}
+	if mask.Has(MaskFieldNameMap) {
+		c.FieldNameMap = other.FieldNameMap
+	}
+	if mask.Has(MaskAlwaysFields) {
+		c.AlwaysFields = mergeAlwaysFields(c.AlwaysFields, other.AlwaysFields)
+	}
+	if mask.Has(MaskIncludeGoroutineID) {
+		c.IncludeGoroutineID = other.IncludeGoroutineID
+	}
+	if mask.Has(MaskIncludeProcessInfo) {
+		c.IncludeProcessInfo = other.IncludeProcessInfo
+	}
+	if mask.Has(MaskIncludeCommit) {
+		c.IncludeCommit = other.IncludeCommit
+	}
+	if mask.Has(MaskSamplingRate) {
+		c.SamplingRate = other.SamplingRate
+	}
+	if mask.Has(MaskSamplingRng) {
+		c.SamplingRng = other.SamplingRng
+	}
+	if mask.Has(MaskRedactKeys) {
+		c.RedactKeys = other.RedactKeys
+	}
+	if mask.Has(MaskMaskRules) {
+		c.MaskRules = other.MaskRules
+	}
+	if mask.Has(MaskColors) {
+		c.Colors = other.Colors
+	}
+	if mask.Has(MaskTUI) {
+		c.TUI = other.TUI
+	}
+	if mask.Has(MaskSelectMaxRetries) {
+		c.SelectMaxRetries = other.SelectMaxRetries
+	}
+	if mask.Has(MaskRequirePassword) {
+		c.RequirePassword = other.RequirePassword
+	}
+	if mask.Has(MaskDedupCacheSize) {
+		c.DedupCacheSize = other.DedupCacheSize
+	}
+	if mask.Has(MaskHTTPSinkTimeout) {
+		c.HTTPSinkTimeout = other.HTTPSinkTimeout
+	}
+	if mask.Has(MaskHTTPSinkRetries) {
+		c.HTTPSinkRetries = other.HTTPSinkRetries
+	}
+	if mask.Has(MaskHooks) {
+		c.Hooks = other.Hooks
+	}
+	if mask.Has(MaskHTTPLevelMap) {
+		c.HTTPLevelMap = other.HTTPLevelMap
+	}
+	if mask.Has(MaskFiles) {
+		c.Files = other.Files
+	}
+	if mask.Has(MaskConfigureZerolog) {
+		c.ConfigureZerolog = other.ConfigureZerolog
+	}
+	if mask.Has(MaskConfigureConsole) {
+		c.ConfigureConsole = other.ConfigureConsole
+	}
+	if mask.Has(MaskConfigureLogger) {
+		c.ConfigureLogger = other.ConfigureLogger
+	}
+	if mask.Has(MaskConfigureWriter) {
+		c.ConfigureWriter = other.ConfigureWriter
+	}
+	return c
+}
+
+// nonZeroMask returns the mask of fields in cfg that hold non-zero values.
+func nonZeroMask(cfg Config) ConfigMask {
+	var mask ConfigMask
+	set := func(ok bool, m ConfigMask) {
+		if ok {
+			mask |= m
+		}
+	}
+	set(cfg.Writer != nil, MaskWriter)
+	set(cfg.Level != 0, MaskLevel)
+	set(cfg.Timestamp, MaskTimestamp)
+	set(cfg.Caller, MaskCaller)
+	set(cfg.Stack, MaskStack)
+	set(cfg.TimestampFunc != nil, MaskTimestampFunc)
+	set(cfg.TimeFormat != "", MaskTimeFormat)
+	set(cfg.NoColor, MaskNoColor)
+	set(cfg.Bypass, MaskBypass)
+	set(cfg.MaxMessageLength != 0, MaskMaxMessageLength)
+	set(cfg.TruncationMarker != "", MaskTruncationMarker)
+	set(cfg.FieldOrder != nil, MaskFieldOrder)
+	set(cfg.FieldNameMap != nil, MaskFieldNameMap)
+	set(cfg.AlwaysFields != nil, MaskAlwaysFields)
+	set(cfg.IncludeGoroutineID, MaskIncludeGoroutineID)
+	set(cfg.IncludeProcessInfo, MaskIncludeProcessInfo)
+	set(cfg.IncludeCommit != "", MaskIncludeCommit)
+	set(cfg.SamplingRate != 0, MaskSamplingRate)
+	set(cfg.SamplingRng != nil, MaskSamplingRng)
+	set(cfg.RedactKeys != nil, MaskRedactKeys)
+	set(cfg.MaskRules != nil, MaskMaskRules)
+	set(cfg.Colors != (ConsoleColors{}), MaskColors)
+	set(cfg.TUI != (TUIConfig{}), MaskTUI)
+	set(cfg.SelectMaxRetries != 0, MaskSelectMaxRetries)
+	set(cfg.RequirePassword, MaskRequirePassword)
+	set(cfg.DedupCacheSize != 0, MaskDedupCacheSize)
+	set(cfg.HTTPSinkTimeout != 0, MaskHTTPSinkTimeout)
+	set(cfg.HTTPSinkRetries != 0, MaskHTTPSinkRetries)
+	set(len(cfg.Hooks) > 0, MaskHooks)
+	set(cfg.HTTPLevelMap != nil, MaskHTTPLevelMap)
+	set(cfg.Files != nil, MaskFiles)
+	set(cfg.ConfigureZerolog != nil, MaskConfigureZerolog)
+	set(cfg.ConfigureConsole != nil, MaskConfigureConsole)
+	set(cfg.ConfigureLogger != nil, MaskConfigureLogger)
+	set(cfg.ConfigureWriter != nil, MaskConfigureWriter)
+	return mask
+}
+
+// mergeAlwaysFields returns a new map holding base's fields overlaid with
+// other's. It returns base unchanged when other is empty.
+func mergeAlwaysFields(base, other map[string]any) map[string]any {
+	if len(other) == 0 {
+		return base
+	}
+	merged := make(map[string]any, len(base)+len(other))
+	maps.Copy(merged, base)
+	maps.Copy(merged, other)
+	return merged
+}
@@ -0,0 +1,96 @@
+package logs
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestMergeFromAppliesNonZeroFields verifies non-zero fields override and zero fields are kept.
+func TestMergeFromAppliesNonZeroFields(t *testing.T) {
+	var out bytes.Buffer
+	base := DefaultConfig()
+	base.Caller = true
+
+	got := base.MergeFrom(Config{
+		Writer:     &out,
+		Level:      ErrorLevel,
+		Bypass:     true,
+		TimeFormat: "15:04:05",
+	})
+
+	if got.Writer != &out || got.Level != ErrorLevel || !got.Bypass || got.TimeFormat != "15:04:05" {
+		t.Fatalf("expected overlay fields applied, got %+v", got)
+	}
+	if !got.Caller || !got.Timestamp {
+		t.Fatal("expected zero-valued overlay bools to keep base values")
+	}
+	if got.Colors != base.Colors || got.TUI != base.TUI {
+		t.Fatal("expected empty overlay colors/TUI to keep base values")
+	}
+}
+
+// TestMergeMaskedAppliesExplicitZeroValues verifies masked fields are copied even when zero.
+func TestMergeMaskedAppliesExplicitZeroValues(t *testing.T) {
+	base := DefaultConfig()
+
+	got := base.MergeMasked(Config{Level: DebugLevel, Timestamp: false}, MaskLevel|MaskTimestamp)
+
+	if got.Level != DebugLevel {
+		t.Fatalf("level: got %v, want %v", got.Level, DebugLevel)
+	}
+	if got.Timestamp {
+		t.Fatal("timestamp: expected explicit false to be applied")
+	}
+	if got.TimeFormat != base.TimeFormat {
+		t.Fatal("expected unmasked fields to keep base values")
+	}
+}
+
+// TestMergeFromMergesAlwaysFields verifies AlwaysFields are combined key by key.
+func TestMergeFromMergesAlwaysFields(t *testing.T) {
+	base := Config{AlwaysFields: map[string]any{"service": "api", "region": "us"}}
+
+	got := base.MergeFrom(Config{AlwaysFields: map[string]any{"region": "eu", "shard": 3}})
+
+	want := map[string]any{"service": "api", "region": "eu", "shard": 3}
+	if !reflect.DeepEqual(got.AlwaysFields, want) {
+		t.Fatalf("got %v, want %v", got.AlwaysFields, want)
+	}
+	if base.AlwaysFields["region"] != "us" {
+		t.Fatal("expected the base map to be unchanged")
+	}
+	if kept := base.MergeMasked(Config{}, MaskAlwaysFields); !reflect.DeepEqual(kept.AlwaysFields, base.AlwaysFields) {
+		t.Fatalf("expected an empty overlay to keep base fields, got %v", kept.AlwaysFields)
+	}
+}
+
+// TestConfigFromFileMaskReportsDefinedKeys verifies only keys present in the file are masked.
+func TestConfigFromFileMaskReportsDefinedKeys(t *testing.T) {
+	path := writeTOML(t, `
+level     = "debug"
+timestamp = false
+
+[colors]
+info = 4
+`)
+
+	cfg, mask, err := ConfigFromFileMask(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !mask.Has(MaskLevel | MaskTimestamp | MaskColors) {
+		t.Fatalf("expected level, timestamp and colors in mask, got %b", mask)
+	}
+	if mask.Has(MaskBypass) || mask.Has(MaskTUI) || mask.Has(MaskFiles) {
+		t.Fatalf("expected absent keys to be unset in mask, got %b", mask)
+	}
+
+	got := DefaultConfig().MergeMasked(cfg, mask)
+	if got.Level != DebugLevel || got.Timestamp {
+		t.Fatalf("expected file values layered over defaults, got level=%v timestamp=%v", got.Level, got.Timestamp)
+	}
+	if got.TUI != DefaultTUIConfig() {
+		t.Fatal("expected unmasked TUI to keep default values")
+	}
+}
@@ -0,0 +1,76 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"net/http/httptest"
+	"testing"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+// TestLogMetricsCountsEventsDropsAndErrors verifies counter increments for each outcome.
+func TestLogMetricsCountsEventsDropsAndErrors(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: WarnLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	before := LogMetrics()
+	Zerolog().Warn().Msg("one")
+	Zerolog().Error().Msg("two")
+	Zerolog().Info().Msg("filtered")
+	after := LogMetrics()
+	if got := after.EventsEmitted - before.EventsEmitted; got != 2 {
+		t.Fatalf("expected 2 emitted events, got %d", got)
+	}
+	if after.CurrentLevel != WarnLevel || after.WriterName != "*bytes.Buffer" {
+		t.Fatalf("unexpected level/writer %v %q", after.CurrentLevel, after.WriterName)
+	}
+
+	Configure(Config{Writer: failingWriter{}, Level: InfoLevel, Bypass: true})
+	before = LogMetrics()
+	Zerolog().Info().Msg("lost")
+	if got := LogMetrics().WriteErrors - before.WriteErrors; got != 1 {
+		t.Fatalf("expected 1 write error, got %d", got)
+	}
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, SamplingRate: 0.5, SamplingRng: rand.New(rand.NewSource(1))})
+	before = LogMetrics()
+	for range 100 {
+		Zerolog().Info().Msg("sampled")
+	}
+	after = LogMetrics()
+	emitted, dropped := after.EventsEmitted-before.EventsEmitted, after.EventsDropped-before.EventsDropped
+	if emitted != 100 || dropped == 0 || dropped == 100 {
+		t.Fatalf("expected 100 emitted with some dropped, got %d emitted, %d dropped", emitted, dropped)
+	}
+}
+
+// TestExposeMetricsHandlerServesJSON verifies the handler returns the snapshot as JSON.
+func TestExposeMetricsHandlerServesJSON(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: ErrorLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	Zerolog().Error().Msg("x")
+
+	rec := httptest.NewRecorder()
+	ExposeMetricsHandler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("expected JSON content type, got %q", ct)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got["current_level"] != "error" || got["writer_name"] != "*bytes.Buffer" {
+		t.Fatalf("unexpected body %v", got)
+	}
+	if n, _ := got["events_emitted"].(float64); n < 1 {
+		t.Fatalf("expected events_emitted >= 1, got %v", got["events_emitted"])
+	}
+}
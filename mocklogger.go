@@ -0,0 +1,86 @@
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// MockLogger is a standalone JSON logger with assertions over what it
+// wrote, for testing code that accepts a Logger. It does not touch the
+// global configuration:
+//
+//	mock, _ := logs.NewMockLogger()
+//	svc := NewService(mock.Logger)
+//	svc.Run()
+//	mock.AssertLogged(t, logs.WarnLevel, "retrying")
+type MockLogger struct {
+	Logger
+	out *mockBuffer
+}
+
+// mockBuffer guards the captured output so code under test can log from
+// several goroutines while assertions read it.
+type mockBuffer struct {
+	mu  sync.Mutex
+	buf *bytes.Buffer
+}
+
+func (b *mockBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// NewMockLogger returns a MockLogger whose Logger writes JSON at every
+// level to the returned buffer. The Logger and the assertions are safe for
+// concurrent use; read the buffer directly only once logging has stopped.
+func NewMockLogger() (*MockLogger, *bytes.Buffer) {
+	out := &mockBuffer{buf: &bytes.Buffer{}}
+	return &MockLogger{Logger: New(out).Level(TraceLevel), out: out}, out.buf
+}
+
+// AssertLogged reports an error on t unless an event at exactly level with
+// a message containing substr was written.
+func (m *MockLogger) AssertLogged(t testing.TB, level Level, substr string) {
+	t.Helper()
+	if ok, out := m.logged(t, level, substr); !ok {
+		t.Errorf("smplog: expected a %s event containing %q, got:\n%s", level, substr, out)
+	}
+}
+
+// AssertNotLogged reports an error on t if an event at exactly level with
+// a message containing substr was written.
+func (m *MockLogger) AssertNotLogged(t testing.TB, level Level, substr string) {
+	t.Helper()
+	if ok, out := m.logged(t, level, substr); ok {
+		t.Errorf("smplog: unexpected %s event containing %q, got:\n%s", level, substr, out)
+	}
+}
+
+// Reset clears the captured output between test cases.
+func (m *MockLogger) Reset() {
+	m.out.mu.Lock()
+	defer m.out.mu.Unlock()
+	m.out.buf.Reset()
+}
+
+// logged reports whether a matching event was written, along with a copy
+// of the output it searched.
+func (m *MockLogger) logged(t testing.TB, level Level, substr string) (bool, string) {
+	t.Helper()
+	m.out.mu.Lock()
+	out := m.out.buf.String()
+	m.out.mu.Unlock()
+	records, err := ParseLogRecords(strings.NewReader(out))
+	if err != nil {
+		t.Errorf("smplog: mock logger output: %v", err)
+	}
+	for _, rec := range records {
+		if rec.Level == level && strings.Contains(rec.Message, substr) {
+			return true, out
+		}
+	}
+	return false, out
+}
@@ -0,0 +1,77 @@
+package logs
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingTB captures Errorf calls so assertion failures can be checked.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// TestMockLoggerAssertions verifies AssertLogged/AssertNotLogged match level and substring.
+func TestMockLoggerAssertions(t *testing.T) {
+	mock, buf := NewMockLogger()
+	mock.Warn().Str("attempt", "2").Msg("retrying upstream")
+	mock.Debug().Msg("cache warm")
+
+	if !strings.Contains(buf.String(), `"message":"retrying upstream"`) {
+		t.Fatalf("expected JSON in returned buffer, got %q", buf.String())
+	}
+	mock.AssertLogged(t, WarnLevel, "retrying")
+	mock.AssertLogged(t, DebugLevel, "cache")
+	mock.AssertNotLogged(t, ErrorLevel, "retrying")
+
+	rec := &recordingTB{TB: t}
+	mock.AssertLogged(rec, InfoLevel, "retrying")
+	mock.AssertNotLogged(rec, WarnLevel, "upstream")
+	if len(rec.errors) != 2 {
+		t.Fatalf("expected 2 assertion failures, got %v", rec.errors)
+	}
+
+	mock.Reset()
+	if buf.Len() != 0 {
+		t.Fatal("expected Reset to clear the buffer")
+	}
+	mock.AssertNotLogged(t, WarnLevel, "retrying")
+}
+
+// TestMockLoggerIgnoresGlobalConfig verifies the mock is independent of Configure.
+func TestMockLoggerIgnoresGlobalConfig(t *testing.T) {
+	Configure(Config{Level: ErrorLevel})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	mock, _ := NewMockLogger()
+	mock.Info().Msg("still captured")
+	mock.AssertLogged(t, InfoLevel, "captured")
+}
+
+// TestMockLoggerConcurrentUse verifies logging from goroutines while asserting does not race.
+func TestMockLoggerConcurrentUse(t *testing.T) {
+	mock, _ := NewMockLogger()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				mock.Info().Int("j", j).Msg("worker")
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		mock.AssertNotLogged(t, ErrorLevel, "worker")
+	}
+	wg.Wait()
+	mock.AssertLogged(t, InfoLevel, "worker")
+}
@@ -0,0 +1,33 @@
+package logs
+
+import "sync"
+
+// onceKeys holds the keys already used by LogOnce/LogOnceAt.
+var onceKeys sync.Map
+
+// LogOnce logs msg at info level the first time it is called with key and
+// does nothing on later calls, for deprecation notices and one-time setup
+// messages:
+//
+//	logs.LogOnce("deprecated-flag", "--legacy is deprecated; use --mode")
+func LogOnce(key, msg string) {
+	LogOnceAt(key, InfoLevel, msg)
+}
+
+// LogOnceAt is LogOnce at the given level.
+func LogOnceAt(key string, level Level, msg string) {
+	if _, seen := onceKeys.LoadOrStore(key, struct{}{}); seen {
+		return
+	}
+	Zerolog().WithLevel(level).Msg(msg)
+}
+
+// ResetOnce forgets key so the next LogOnce/LogOnceAt call with it logs again.
+func ResetOnce(key string) {
+	onceKeys.Delete(key)
+}
+
+// ResetAllOnce forgets every LogOnce/LogOnceAt key.
+func ResetAllOnce() {
+	onceKeys.Clear()
+}
@@ -0,0 +1,59 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestLogOnceLogsOnce verifies repeated calls with one key write a single line.
+func TestLogOnceLogsOnce(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() {
+		ResetAllOnce()
+		Configure(DefaultConfig())
+	})
+
+	for range 100 {
+		LogOnce("deprecated", "old API in use")
+	}
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 line, got %d", len(lines))
+	}
+	if lines[0]["level"] != "info" || lines[0]["message"] != "old API in use" {
+		t.Fatalf("unexpected line %v", lines[0])
+	}
+}
+
+// TestLogOnceAtAndReset verifies level control and that resets allow logging again.
+func TestLogOnceAtAndReset(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() {
+		ResetAllOnce()
+		Configure(DefaultConfig())
+	})
+
+	LogOnceAt("a", WarnLevel, "first a")
+	LogOnceAt("a", WarnLevel, "second a")
+	LogOnce("b", "first b")
+	ResetOnce("a")
+	LogOnceAt("a", ErrorLevel, "third a")
+	ResetAllOnce()
+	LogOnce("b", "second b")
+
+	lines := decodeLines(t, out.String())
+	want := []struct{ level, msg string }{
+		{"warn", "first a"}, {"info", "first b"}, {"error", "third a"}, {"info", "second b"},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d: %v", len(want), len(lines), lines)
+	}
+	for i, w := range want {
+		if lines[i]["level"] != w.level || lines[i]["message"] != w.msg {
+			t.Errorf("line %d: got %v, want %+v", i, lines[i], w)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Package otelsink forwards smplog events to an OpenTelemetry logger. It is
+// kept out of the core logs package so only callers that use it depend on
+// the OpenTelemetry modules.
+package otelsink
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/danmuck/smplog/internal/jsonline"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+// otelQueueSize bounds the records waiting to be emitted to OTel loggers.
+const otelQueueSize = 1024
+
+type otelItem struct {
+	logger otellog.Logger
+	record otellog.Record
+}
+
+var (
+	otelOnce  sync.Once
+	otelQueue chan otelItem
+)
+
+// otelEnqueue hands rec to the shared emitter goroutine without blocking.
+// It reports false when the queue is full.
+func otelEnqueue(logger otellog.Logger, rec otellog.Record) bool {
+	otelOnce.Do(func() {
+		otelQueue = make(chan otelItem, otelQueueSize)
+		go func() {
+			for item := range otelQueue {
+				item.logger.Emit(context.Background(), item.record)
+			}
+		}()
+	})
+	select {
+	case otelQueue <- otelItem{logger: logger, record: rec}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Tap returns a logs.Config.ConfigureWriter func that forwards every event
+// to an OpenTelemetry logger from provider, with the level mapped to a
+// severity, the message as body and other fields as attributes. Forwarding
+// is asynchronous; events are dropped when the queue is full and counted in
+// dropped when it is non-nil. Output to the wrapped writer is unchanged.
+//
+//	var dropped atomic.Int64
+//	logs.Configure(logs.Config{ConfigureWriter: otelsink.Tap(provider, &dropped)})
+func Tap(provider otellog.LoggerProvider, dropped *atomic.Int64) func(io.Writer) io.Writer {
+	logger := provider.Logger("github.com/danmuck/smplog")
+	return func(w io.Writer) io.Writer {
+		return otelWriter{w: w, logger: logger, dropped: dropped}
+	}
+}
+
+// otelWriter forwards each JSON log line to an OTel logger before passing it
+// on unchanged. ConfigureWriter places it ahead of the ConsoleWriter so it
+// always sees zerolog's JSON, with every field intact (hooks cannot read
+// fields).
+type otelWriter struct {
+	w       io.Writer
+	logger  otellog.Logger
+	dropped *atomic.Int64
+}
+
+func (o otelWriter) Write(p []byte) (int, error) {
+	o.forward(p)
+	return o.w.Write(p)
+}
+
+// WriteLevel forwards p like Write and passes level on when the wrapped
+// writer is a zerolog.LevelWriter.
+func (o otelWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	lw, ok := o.w.(zerolog.LevelWriter)
+	if !ok {
+		return o.Write(p)
+	}
+	o.forward(p)
+	return lw.WriteLevel(level, p)
+}
+
+// forward enqueues the JSON line p as an OTel record.
+func (o otelWriter) forward(p []byte) {
+	if fields, err := jsonline.ParseObject(p); err == nil {
+		if !otelEnqueue(o.logger, otelRecord(fields)) && o.dropped != nil {
+			o.dropped.Add(1)
+		}
+	}
+}
+
+// otelRecord converts a parsed log line to an OTel record. The level,
+// message and timestamp fields map to severity, body and timestamp; every
+// other field becomes an attribute.
+func otelRecord(fields []jsonline.Field) otellog.Record {
+	var rec otellog.Record
+	now := time.Now()
+	rec.SetObservedTimestamp(now)
+	rec.SetTimestamp(now)
+	for _, f := range fields {
+		switch f.Key {
+		case zerolog.LevelFieldName:
+			var level string
+			json.Unmarshal(f.Value, &level)
+			rec.SetSeverityText(level)
+			rec.SetSeverity(otelSeverity(level))
+		case zerolog.MessageFieldName:
+			var msg string
+			json.Unmarshal(f.Value, &msg)
+			rec.SetBody(attribute.StringValue(msg))
+		case zerolog.TimestampFieldName:
+			if t, ok := jsonline.ParseTimestamp(f.Value); ok {
+				rec.SetTimestamp(t)
+			}
+		default:
+			rec.AddAttributes(otelAttribute(f))
+		}
+	}
+	return rec
+}
+
+// otelSeverity maps a zerolog level name to an OTel severity.
+func otelSeverity(level string) otellog.Severity {
+	switch level {
+	case "trace":
+		return otellog.SeverityTrace
+	case "debug":
+		return otellog.SeverityDebug
+	case "info":
+		return otellog.SeverityInfo
+	case "warn":
+		return otellog.SeverityWarn
+	case "error":
+		return otellog.SeverityError
+	case "fatal":
+		return otellog.SeverityFatal
+	case "panic":
+		return otellog.SeverityFatal4
+	default:
+		return otellog.SeverityUndefined
+	}
+}
+
+// otelAttribute converts a JSON field to a typed attribute. Objects, arrays
+// and nulls are kept as their raw JSON text.
+func otelAttribute(f jsonline.Field) attribute.KeyValue {
+	var v any
+	if err := json.Unmarshal(f.Value, &v); err == nil {
+		switch v := v.(type) {
+		case string:
+			return attribute.String(f.Key, v)
+		case bool:
+			return attribute.Bool(f.Key, v)
+		case float64:
+			if n, err := strconv.ParseInt(string(f.Value), 10, 64); err == nil {
+				return attribute.Int64(f.Key, n)
+			}
+			return attribute.Float64(f.Key, v)
+		}
+	}
+	return attribute.String(f.Key, string(f.Value))
+}
@@ -0,0 +1,138 @@
+package otelsink
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	logs "github.com/danmuck/smplog"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+)
+
+// fakeOTelProvider hands out its single fakeOTel logger.
+type fakeOTelProvider struct {
+	embedded.LoggerProvider
+	logger *fakeOTel
+}
+
+func (p fakeOTelProvider) Logger(string, ...otellog.LoggerOption) otellog.Logger { return p.logger }
+
+// fakeOTel is an OTel Logger that records emitted records. Emit blocks
+// while block is non-nil and open.
+type fakeOTel struct {
+	embedded.Logger
+
+	mu      sync.Mutex
+	records []otellog.Record
+	block   chan struct{}
+}
+
+func (f *fakeOTel) Enabled(context.Context, otellog.EnabledParameters) bool { return true }
+
+func (f *fakeOTel) Emit(_ context.Context, rec otellog.Record) {
+	if f.block != nil {
+		<-f.block
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.records = append(f.records, rec)
+}
+
+func (f *fakeOTel) waitRecords(t *testing.T, n int) []otellog.Record {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		f.mu.Lock()
+		got := append([]otellog.Record(nil), f.records...)
+		f.mu.Unlock()
+		if len(got) >= n {
+			return got
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d OTel records, got %d", n, len(got))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// TestTapForwardsRecords verifies severity, body and attributes are mapped.
+func TestTapForwardsRecords(t *testing.T) {
+	var out bytes.Buffer
+	provider := &fakeOTel{}
+
+	logs.Configure(logs.Config{Writer: &out, Level: logs.InfoLevel, NoColor: true, ConfigureWriter: Tap(fakeOTelProvider{logger: provider}, nil)})
+	t.Cleanup(func() { logs.Configure(logs.DefaultConfig()) })
+
+	logs.Zerolog().Warn().Str("user", "ada").Int("attempt", 2).Bool("ok", false).Msg("retrying")
+
+	rec := provider.waitRecords(t, 1)[0]
+	if rec.Severity() != otellog.SeverityWarn || rec.SeverityText() != "warn" {
+		t.Fatalf("unexpected severity %v/%q", rec.Severity(), rec.SeverityText())
+	}
+	if rec.Body().AsString() != "retrying" {
+		t.Fatalf("unexpected body %v", rec.Body())
+	}
+	attrs := map[string]attribute.Value{}
+	rec.WalkAttributes(func(kv attribute.KeyValue) bool {
+		attrs[string(kv.Key)] = kv.Value
+		return true
+	})
+	if attrs["user"].AsString() != "ada" || attrs["attempt"].AsInt64() != 2 || attrs["ok"].Type() != attribute.BOOL {
+		t.Fatalf("unexpected attributes %v", attrs)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("retrying")) {
+		t.Fatalf("expected console output to be unaffected, got %q", out.String())
+	}
+}
+
+// TestTapCountsDroppedOnFullQueue verifies events are dropped, not blocked, when the queue is full.
+func TestTapCountsDroppedOnFullQueue(t *testing.T) {
+	var dropped atomic.Int64
+	provider := &fakeOTel{block: make(chan struct{})}
+
+	logs.Configure(logs.Config{Writer: &bytes.Buffer{}, Level: logs.InfoLevel, Bypass: true, ConfigureWriter: Tap(fakeOTelProvider{logger: provider}, &dropped)})
+	t.Cleanup(func() { logs.Configure(logs.DefaultConfig()) })
+
+	for i := 0; i < otelQueueSize+10; i++ {
+		logs.Info("flood")
+	}
+	close(provider.block)
+
+	if dropped.Load() == 0 {
+		t.Fatal("expected dropped events to be counted")
+	}
+	provider.waitRecords(t, otelQueueSize)
+}
+
+// levelRecorder is a zerolog.LevelWriter that records the level of each write.
+type levelRecorder struct {
+	bytes.Buffer
+	levels []zerolog.Level
+}
+
+func (r *levelRecorder) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	r.levels = append(r.levels, level)
+	return r.Write(p)
+}
+
+// TestTapForwardsWriteLevel verifies a LevelWriter destination still receives levels behind the tap.
+func TestTapForwardsWriteLevel(t *testing.T) {
+	var out levelRecorder
+	provider := &fakeOTel{}
+
+	logs.Configure(logs.Config{Writer: &out, Level: logs.InfoLevel, Bypass: true, ConfigureWriter: Tap(fakeOTelProvider{logger: provider}, nil)})
+	t.Cleanup(func() { logs.Configure(logs.DefaultConfig()) })
+
+	logs.Zerolog().Error().Msg("leveled")
+
+	provider.waitRecords(t, 1)
+	if len(out.levels) != 1 || out.levels[0] != zerolog.ErrorLevel {
+		t.Fatalf("expected one error WriteLevel call, got %v", out.levels)
+	}
+}
@@ -0,0 +1,83 @@
+package logs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// xtermBase holds the conventional xterm RGB values for palette indexes 0..15.
+var xtermBase = [16][3]int{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// color256RGB approximates the RGB value of a 256-color palette index using
+// the xterm defaults; see the palette structure notes in colors.go.
+func color256RGB(n int) (r, g, b int) {
+	switch {
+	case n < 16:
+		c := xtermBase[n]
+		return c[0], c[1], c[2]
+	case n < 232:
+		level := func(v int) int {
+			if v == 0 {
+				return 0
+			}
+			return 55 + 40*v
+		}
+		n -= 16
+		return level(n / 36), level(n / 6 % 6), level(n % 6)
+	default:
+		v := 8 + 10*(n-232)
+		return v, v, v
+	}
+}
+
+// renderColorTable renders the 256-color palette as a 16×16 grid, each cell
+// showing its index in that foreground color.
+func renderColorTable() string {
+	var b strings.Builder
+	for row := 0; row < 16; row++ {
+		for col := 0; col < 16; col++ {
+			n := row*16 + col
+			b.WriteString(colorize(StyleColor256(n), fmt.Sprintf("%4d", n), false))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Table256Colors prints the 256-color palette grid to the TUI output (stdout
+// by default) and returns the rendered text. Use it to pick indexes for
+// StyleColor256 or [colors].
+func Table256Colors() string {
+	table := renderColorTable()
+	fmt.Fprint(tuiOutput(Configured()), table)
+	return table
+}
+
+// Table256ColorsWriter writes the 256-color palette grid to w.
+func Table256ColorsWriter(w io.Writer) (int, error) {
+	return io.WriteString(w, renderColorTable())
+}
+
+// Table256ColorsHTML returns the 256-color palette as an HTML table, each
+// cell showing its index in the approximate xterm RGB color.
+func Table256ColorsHTML() string {
+	var b strings.Builder
+	b.WriteString("<table class=\"smplog-palette\">\n")
+	for row := 0; row < 16; row++ {
+		b.WriteString("<tr>")
+		for col := 0; col < 16; col++ {
+			n := row*16 + col
+			r, g, bl := color256RGB(n)
+			fmt.Fprintf(&b, "<td style=\"color:#%02x%02x%02x\">%d</td>", r, g, bl, n)
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</table>\n")
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestTable256ColorsWriterGrid verifies a 16×16 grid with each index in its own color.
+func TestTable256ColorsWriterGrid(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := Table256ColorsWriter(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(rows) != 16 {
+		t.Fatalf("expected 16 rows, got %d", len(rows))
+	}
+	if fields := strings.Fields(StripANSI(rows[15])); len(fields) != 16 || fields[15] != "255" {
+		t.Fatalf("unexpected last row %q", fields)
+	}
+	if !strings.Contains(buf.String(), StyleColor256(196)+" 196"+StyleReset) {
+		t.Fatal("expected index 196 rendered in its own color")
+	}
+}
+
+// TestTable256ColorsPrintsToStdout verifies the stdout variant prints what it returns.
+func TestTable256ColorsPrintsToStdout(t *testing.T) {
+	var table string
+	out := captureStdout(t, func() { table = Table256Colors() })
+	if out != table || table == "" {
+		t.Fatalf("expected stdout to match the returned table")
+	}
+}
+
+// TestTable256ColorsHTML verifies the table shape and palette RGB mapping.
+func TestTable256ColorsHTML(t *testing.T) {
+	got := Table256ColorsHTML()
+	if n := strings.Count(got, "<tr>"); n != 16 {
+		t.Fatalf("expected 16 rows, got %d", n)
+	}
+	if n := strings.Count(got, "<td "); n != 256 {
+		t.Fatalf("expected 256 cells, got %d", n)
+	}
+	for _, cell := range []string{
+		`<td style="color:#ff0000">9</td>`,
+		`<td style="color:#ff0000">196</td>`,
+		`<td style="color:#080808">232</td>`,
+		`<td style="color:#eeeeee">255</td>`,
+	} {
+		if !strings.Contains(got, cell) {
+			t.Errorf("expected %s in HTML table", cell)
+		}
+	}
+}
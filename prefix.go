@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// PrefixWriter returns a writer that inserts prefix before every line
+// written to w, e.g. to tell services apart when their output shares a
+// terminal:
+//
+//	logs.Configure(logs.Config{Writer: logs.PrefixWriter(os.Stdout, "api  | ")})
+//
+// Input without a trailing newline is buffered until the newline arrives,
+// so a line split across Write calls still gets a single prefix.
+func PrefixWriter(w io.Writer, prefix string) io.Writer {
+	return &prefixWriter{w: w, prefix: []byte(prefix)}
+}
+
+// ColorPrefixWriter is PrefixWriter with prefix wrapped in color, as
+// colorize does.
+func ColorPrefixWriter(w io.Writer, color, prefix string) io.Writer {
+	return PrefixWriter(w, colorize(color, prefix, false))
+}
+
+type prefixWriter struct {
+	w      io.Writer
+	prefix []byte
+
+	mu      sync.Mutex
+	pending []byte
+}
+
+// Write prefixes each complete line in p (together with any buffered
+// partial line) and writes them to w in one call.
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+	pw.pending = append(pw.pending, p...)
+	end := bytes.LastIndexByte(pw.pending, '\n')
+	if end < 0 {
+		return len(p), nil
+	}
+	var out []byte
+	for rest := pw.pending[:end+1]; len(rest) > 0; {
+		i := bytes.IndexByte(rest, '\n') + 1
+		out = append(out, pw.prefix...)
+		out = append(out, rest[:i]...)
+		rest = rest[i:]
+	}
+	pw.pending = append(pw.pending[:0], pw.pending[end+1:]...)
+	if _, err := pw.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
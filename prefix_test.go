@@ -0,0 +1,39 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestPrefixWriterPrefixesEachLine verifies multi-line writes and buffering of partial lines.
+func TestPrefixWriterPrefixesEachLine(t *testing.T) {
+	var out bytes.Buffer
+	w := PrefixWriter(&out, "api | ")
+
+	w.Write([]byte("one\ntwo\n"))
+	w.Write([]byte("thr"))
+	if out.String() != "api | one\napi | two\n" {
+		t.Fatalf("expected partial line buffered, got %q", out.String())
+	}
+	w.Write([]byte("ee\nfour\nfi"))
+	w.Write([]byte("ve\n"))
+
+	want := "api | one\napi | two\napi | three\napi | four\napi | five\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
+
+// TestColorPrefixWriterColorsPrefix verifies the prefix is colorized and the line is not.
+func TestColorPrefixWriterColorsPrefix(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: ColorPrefixWriter(&out, StyleColor256(Cyan), "db | "), Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Msg("ready")
+
+	want := StyleColor256(Cyan) + "db | " + StyleReset + `{"level":"info","message":"ready"}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
@@ -0,0 +1,104 @@
+package logs
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// NewPrettyWriter wraps w so each JSON log line (bypass mode) is written as
+// human-readable text:
+//
+//	INFO  2024-01-02T03:04:05Z server started addr=:8080 tls=true
+//
+// The level is upper-cased and padded to 5 characters, followed by the
+// timestamp and message when present; remaining fields follow as key=value
+// pairs sorted by key. String values containing spaces, quotes or '=' are
+// quoted. Lines that are not JSON objects pass through unchanged.
+func NewPrettyWriter(w io.Writer) io.Writer {
+	return prettyWriter{w: w}
+}
+
+type prettyWriter struct {
+	w io.Writer
+}
+
+func (w prettyWriter) Write(p []byte) (int, error) {
+	fields, err := parseJSONObject(p)
+	if err != nil {
+		return w.w.Write(p)
+	}
+	if _, err := w.w.Write(appendPrettyLine(nil, fields)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// appendPrettyLine renders fields as one pretty text line onto dst.
+func appendPrettyLine(dst []byte, fields []jsonField) []byte {
+	var level, ts, msg string
+	rest := make([]jsonField, 0, len(fields))
+	for _, f := range fields {
+		switch f.Key {
+		case zerolog.LevelFieldName:
+			level = prettyText(f.Value)
+		case zerolog.TimestampFieldName:
+			ts = prettyText(f.Value)
+		case zerolog.MessageFieldName:
+			msg = prettyText(f.Value)
+		default:
+			rest = append(rest, f)
+		}
+	}
+	slices.SortStableFunc(rest, func(a, b jsonField) int { return strings.Compare(a.Key, b.Key) })
+
+	var parts []string
+	if level != "" {
+		parts = append(parts, padRight(strings.ToUpper(level), 5))
+	}
+	for _, s := range []string{ts, msg} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	for _, f := range rest {
+		parts = append(parts, f.Key+"="+prettyValue(f.Value))
+	}
+	dst = append(dst, strings.Join(parts, " ")...)
+	return append(dst, '\n')
+}
+
+// prettyText returns a JSON string value unquoted, or any other value as
+// its raw JSON.
+func prettyText(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// prettyValue is prettyText but quotes strings that would be ambiguous in a
+// key=value list. Numbers, booleans, arrays and objects stay raw JSON.
+func prettyValue(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		return string(raw)
+	}
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// padRight pads s with spaces to at least width runes.
+func padRight(s string, width int) string {
+	if n := len([]rune(s)); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
@@ -0,0 +1,36 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestPrettyWriterFormatsBypassLines verifies level, timestamp, message and sorted key=value pairs.
+func TestPrettyWriterFormatsBypassLines(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: NewPrettyWriter(&out), Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Str("zone", "us east").Int("port", 8080).Bool("tls", true).
+		Interface("tags", []string{"a"}).Msg("server started")
+	Zerolog().Warn().Msg("no fields")
+
+	want := `INFO  server started port=8080 tags=["a"] tls=true zone="us east"` + "\n" +
+		"WARN  no fields\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
+
+// TestPrettyWriterIncludesTimestamp verifies the timestamp sits between level and message.
+func TestPrettyWriterIncludesTimestamp(t *testing.T) {
+	var out bytes.Buffer
+	w := NewPrettyWriter(&out)
+	w.Write([]byte(`{"message":"hi","time":"2024-01-02T03:04:05Z","level":"error","k":""}`))
+	w.Write([]byte("not json\n"))
+
+	want := "ERROR 2024-01-02T03:04:05Z hi k=\"\"\nnot json\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
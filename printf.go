@@ -2,22 +2,24 @@ package logs
 
 import (
 	"fmt"
-	"os"
+	"io"
+	"strings"
+	"sync"
 )
 
 // Print writes msg to stdout.
 func Print(msg string) (int, error) {
-	return fmt.Fprint(os.Stdout, msg)
+	return writeStdout(Configured(), msg)
 }
 
 // Printf writes a formatted message to stdout.
 func Printf(format string, v ...any) (int, error) {
-	return fmt.Fprintf(os.Stdout, format, v...)
+	return writeStdout(Configured(), fmt.Sprintf(format, v...))
 }
 
 // Println writes msg and a trailing newline to stdout.
 func Println(msg string) (int, error) {
-	return fmt.Fprintln(os.Stdout, msg)
+	return writeStdout(Configured(), msg+"\n")
 }
 
 // Colorf writes a formatted message to stdout with an ANSI style prefix.
@@ -26,8 +28,62 @@ func Colorf(color, format string, v ...any) (int, error) {
 	return printfColorf(color, format, v...)
 }
 
+// Printw writes msg to w in color, e.g. to an http.ResponseWriter or
+// net.Conn. When Config.NoColor is enabled, msg is written without ANSI
+// escapes. TUI.LeftMargin applies only to stdout and is not added.
+func Printw(w io.Writer, color, msg string) (int, error) {
+	return fmt.Fprint(w, colorize(color, msg, Configured().NoColor))
+}
+
+// Printlnw is Printw with a trailing newline after the styled text.
+func Printlnw(w io.Writer, color, msg string) (int, error) {
+	return fmt.Fprint(w, colorize(color, msg, Configured().NoColor)+"\n")
+}
+
 func printfColorf(color, format string, v ...any) (int, error) {
-	cfg := Configured()
-	text := fmt.Sprintf(format, v...)
-	return fmt.Fprint(os.Stdout, colorize(color, text, cfg.NoColor))
+	return writeColored(Configured(), color, fmt.Sprintf(format, v...))
+}
+
+// writeColored writes text to stdout using color and cfg.NoColor.
+func writeColored(cfg Config, color, text string) (int, error) {
+	return writeStdout(cfg, colorize(color, text, cfg.NoColor))
+}
+
+// stdoutAtLineStart records whether the last helper write to stdout ended a
+// line, so TUIConfig.LeftMargin is inserted only at the start of lines.
+var (
+	stdoutMu          sync.Mutex
+	stdoutAtLineStart = true
+)
+
+// writeStdout writes s to the TUI output (stdout by default; see
+// tuiOutput) for the print and TUI helpers, inserting cfg.TUI.LeftMargin
+// spaces before each non-empty line.
+func writeStdout(cfg Config, s string) (int, error) {
+	w := tuiOutput(cfg)
+	stdoutMu.Lock()
+	defer stdoutMu.Unlock()
+	if s == "" {
+		return fmt.Fprint(w, s)
+	}
+	out := s
+	if margin := cfg.TUI.LeftMargin; margin > 0 {
+		out = indentLines(s, strings.Repeat(" ", margin), stdoutAtLineStart)
+	}
+	stdoutAtLineStart = strings.HasSuffix(s, "\n")
+	return fmt.Fprint(w, out)
+}
+
+// indentLines inserts margin before every non-empty line of s. The first
+// line counts as a line start only when atLineStart is set.
+func indentLines(s, margin string, atLineStart bool) string {
+	var b strings.Builder
+	for line := range strings.SplitAfterSeq(s, "\n") {
+		if atLineStart && line != "" && line != "\n" {
+			b.WriteString(margin)
+		}
+		b.WriteString(line)
+		atLineStart = true
+	}
+	return b.String()
 }
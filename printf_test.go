@@ -126,3 +126,33 @@ func TestDividerUsesDefaultWidth(t *testing.T) {
 		t.Fatalf("expected divider ANSI color in output: %q", out)
 	}
 }
+
+func TestPrintwWritesToExplicitWriter(t *testing.T) {
+	Configure(Config{NoColor: false})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	red := StyleColor256(Red)
+	var buf bytes.Buffer
+	stdout := captureStdout(t, func() {
+		if _, err := Printw(&buf, red, "alert"); err != nil {
+			t.Fatalf("printw: %v", err)
+		}
+		if _, err := Printlnw(&buf, "", "plain"); err != nil {
+			t.Fatalf("printlnw: %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected nothing on stdout, got %q", stdout)
+	}
+	if want := red + "alert" + StyleReset + "plain\n"; buf.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", buf.String(), want)
+	}
+
+	Configure(Config{NoColor: true})
+	buf.Reset()
+	_, _ = Printlnw(&buf, red, "alert")
+	if buf.String() != "alert\n" {
+		t.Fatalf("expected NoColor output, got %q", buf.String())
+	}
+}
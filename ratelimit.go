@@ -0,0 +1,84 @@
+package logs
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimitNow is the clock used by RateLimit; tests replace it.
+var rateLimitNow = time.Now
+
+// RateLimit returns a child of the active logger that allows n events per
+// window at their original level. Excess events within the window are
+// re-emitted at overflow instead of being dropped. Events already at or below
+// overflow pass through without consuming tokens.
+//
+// Tokens refill continuously at n per window. When a token becomes available
+// after events were demoted, a single "rate limit cleared" event is logged at
+// overflow before the next event.
+//
+// Demoted events are re-emitted with their message only: zerolog hooks cannot
+// read fields added to the original event, so those are not carried over.
+func RateLimit(n int, window time.Duration, overflow Level) Logger {
+	base := *Zerolog()
+	h := &rateLimitHook{
+		base:     base,
+		overflow: overflow,
+		capacity: float64(n),
+		tokens:   float64(n),
+		last:     rateLimitNow(),
+	}
+	if window > 0 {
+		h.rate = float64(n) / float64(window)
+	}
+	return base.Hook(h)
+}
+
+// rateLimitHook is a token-bucket zerolog Hook used by RateLimit.
+type rateLimitHook struct {
+	base     Logger // logger without the hook, used for re-emission
+	overflow Level
+
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	rate     float64 // tokens per nanosecond
+	last     time.Time
+	limited  bool
+}
+
+func (h *rateLimitHook) Run(e *Event, level Level, msg string) {
+	if level <= h.overflow || level == NoLevel {
+		return
+	}
+	allowed, cleared := h.take()
+	if cleared {
+		h.base.WithLevel(h.overflow).Msg("rate limit cleared")
+	}
+	if allowed {
+		return
+	}
+	e.Discard()
+	h.base.WithLevel(h.overflow).Msg(msg)
+}
+
+// take consumes a token if one is available. cleared reports that this is
+// the first allowed event after a run of demoted ones.
+func (h *rateLimitHook) take() (allowed, cleared bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := rateLimitNow()
+	h.tokens += float64(now.Sub(h.last)) * h.rate
+	if h.tokens > h.capacity {
+		h.tokens = h.capacity
+	}
+	h.last = now
+	if h.tokens < 1 {
+		h.limited = true
+		return false, false
+	}
+	h.tokens--
+	cleared = h.limited
+	h.limited = false
+	return true, cleared
+}
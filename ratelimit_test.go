@@ -0,0 +1,59 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+// TestRateLimitDemotesOverflowEvents verifies excess events are re-emitted at the overflow level.
+func TestRateLimitDemotesOverflowEvents(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: DebugLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer func(fn func() time.Time) { rateLimitNow = fn }(rateLimitNow)
+	rateLimitNow = func() time.Time { return now }
+
+	l := RateLimit(2, 50*time.Millisecond, DebugLevel)
+	for i := 0; i < 5; i++ {
+		l.Info().Msg("burst")
+	}
+	l.Debug().Msg("already low")
+
+	lines := decodeLines(t, out.String())
+	levels := make([]any, len(lines))
+	for i, line := range lines {
+		levels[i] = line["level"]
+	}
+	want := []any{"info", "info", "debug", "debug", "debug", "debug"}
+	if len(levels) != len(want) {
+		t.Fatalf("expected %d lines, got %v", len(want), levels)
+	}
+	for i := range want {
+		if levels[i] != want[i] {
+			t.Fatalf("line %d: got level %v, want %v (all: %v)", i, levels[i], want[i], levels)
+		}
+	}
+
+	out.Reset()
+	now = now.Add(25 * time.Millisecond) // half a window refills one token
+	l.Info().Msg("after refill")
+	l.Info().Msg("still limited")
+
+	lines = decodeLines(t, out.String())
+	if len(lines) != 3 {
+		t.Fatalf("expected cleared notice and two events, got %q", out.String())
+	}
+	if lines[0]["message"] != "rate limit cleared" || lines[0]["level"] != "debug" {
+		t.Fatalf("expected debug rate limit cleared, got %v", lines[0])
+	}
+	if lines[1]["message"] != "after refill" || lines[1]["level"] != "info" {
+		t.Fatalf("expected info event after refill, got %v", lines[1])
+	}
+	if lines[2]["level"] != "debug" {
+		t.Fatalf("expected the refilled token to be used up, got %v", lines[2])
+	}
+}
@@ -0,0 +1,99 @@
+package logs
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+const redactedValue = "[REDACTED]"
+
+// Redact returns a logger built from the active configuration with keys
+// added to Config.RedactKeys: the values of those top-level fields are
+// written as "[REDACTED]". Each line is tokenized into its top-level fields
+// and re-encoded; other values are copied through as raw JSON. The logger is
+// a snapshot; call Redact again after Configure.
+//
+//	l := logs.Redact("password", "token")
+//	l.Info().Str("password", pw).Msg("login")
+func Redact(keys ...string) Logger {
+	cfg := Configured()
+	cfg.RedactKeys = append(append([]string(nil), cfg.RedactKeys...), keys...)
+	cfg.ConfigureZerolog = nil
+	return buildLogger(cfg)
+}
+
+// redactFields returns a rewrite that replaces the values of keys with
+// redactedValue.
+func redactFields(keys []string) func([]jsonField) []jsonField {
+	set := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		set[k] = true
+	}
+	redacted, _ := json.Marshal(redactedValue)
+	return func(fields []jsonField) []jsonField {
+		for i, f := range fields {
+			if set[f.Key] {
+				fields[i].Value = redacted
+			}
+		}
+		return fields
+	}
+}
+
+// MaskRule replaces every match of Pattern in the value of field Key with
+// Replacement, as regexp.ReplaceAllString does ($1 expands to a group).
+type MaskRule struct {
+	Key         string
+	Replacement string
+	Pattern     *regexp.Regexp
+}
+
+// MaskPattern returns a logger built from the active configuration with a
+// MaskRule for key added to Config.MaskRules. The logger is a snapshot; call
+// MaskPattern again after Configure.
+//
+//	l := logs.MaskPattern("card", regexp.MustCompile(`^\d+(\d{4})$`), "****$1")
+//	l.Info().Str("card", "4111111111111111").Msg("charge") // card: "****1111"
+func MaskPattern(key string, pattern *regexp.Regexp, replacement string) Logger {
+	cfg := Configured()
+	cfg.MaskRules = append(append([]MaskRule(nil), cfg.MaskRules...), MaskRule{Key: key, Replacement: replacement, Pattern: pattern})
+	cfg.ConfigureZerolog = nil
+	return buildLogger(cfg)
+}
+
+// maskFields returns a rewrite that applies rules to matching fields.
+// String values are masked as text; other scalar values (numbers, bools)
+// are masked on their JSON text and written as a string when changed.
+// Objects and arrays are left untouched.
+func maskFields(rules []MaskRule) func([]jsonField) []jsonField {
+	return func(fields []jsonField) []jsonField {
+		for i, f := range fields {
+			for _, rule := range rules {
+				if rule.Key == f.Key && rule.Pattern != nil {
+					fields[i].Value = maskValue(fields[i].Value, rule)
+				}
+			}
+		}
+		return fields
+	}
+}
+
+func maskValue(raw json.RawMessage, rule MaskRule) json.RawMessage {
+	var text string
+	switch {
+	case len(raw) == 0 || raw[0] == '{' || raw[0] == '[':
+		return raw
+	case raw[0] == '"':
+		if json.Unmarshal(raw, &text) != nil {
+			return raw
+		}
+	default:
+		text = string(raw)
+	}
+	masked := rule.Pattern.ReplaceAllString(text, rule.Replacement)
+	if masked == text && raw[0] != '"' {
+		return raw
+	}
+	out, _ := json.Marshal(masked)
+	return out
+}
@@ -0,0 +1,77 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// TestRedactMasksSensitiveFields verifies password and token values are replaced.
+func TestRedactMasksSensitiveFields(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := Redact("password", "token")
+	l.Info().Str("user", "ana").Str("password", "hunter2").Str("token", "abc123").Msg("login")
+
+	if strings.Contains(out.String(), "hunter2") || strings.Contains(out.String(), "abc123") {
+		t.Fatalf("expected secrets masked, got %s", out.String())
+	}
+	lines := decodeLines(t, out.String())
+	if lines[0]["password"] != redactedValue || lines[0]["token"] != redactedValue || lines[0]["user"] != "ana" {
+		t.Fatalf("unexpected fields %v", lines[0])
+	}
+}
+
+// TestConfigRedactKeysAppliesInConsoleMode verifies global redaction, including non-string values.
+func TestConfigRedactKeysAppliesInConsoleMode(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, NoColor: true, RedactKeys: []string{"card", "err"}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Int("card", 4111111111111111).AnErr("err", errors.New("secret")).Msg("charge")
+
+	got := out.String()
+	if strings.Contains(got, "4111") || strings.Contains(got, "secret") {
+		t.Fatalf("expected values masked, got %q", got)
+	}
+	if strings.Count(got, redactedValue) != 2 {
+		t.Fatalf("expected both fields shown as redacted, got %q", got)
+	}
+}
+
+// TestMaskPatternMasksPartOfValue verifies pattern replacement on string and numeric fields.
+func TestMaskPatternMasksPartOfValue(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{
+		Writer: &out,
+		Level:  InfoLevel,
+		Bypass: true,
+		MaskRules: []MaskRule{
+			{Key: "phone", Pattern: regexp.MustCompile(`\d{3}$`), Replacement: "XXX"},
+		},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := MaskPattern("card", regexp.MustCompile(`^\d+(\d{4})$`), "****$1")
+	l.Info().Str("card", "4111111111111111").Str("phone", "555-0123").Msg("a")
+	l.Info().Int("card", 4111111111111234).Int("other", 12345).Msg("b")
+	l.Info().Str("card", "n/a").Msg("c")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	if lines[0]["card"] != "****1111" || lines[0]["phone"] != "555-0XXX" {
+		t.Fatalf("unexpected masked fields %v", lines[0])
+	}
+	if lines[1]["card"] != "****1234" || lines[1]["other"] != float64(12345) {
+		t.Fatalf("unexpected numeric masking %v", lines[1])
+	}
+	if lines[2]["card"] != "n/a" {
+		t.Fatalf("expected non-matching value unchanged, got %v", lines[2]["card"])
+	}
+}
@@ -0,0 +1,126 @@
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ReplayBuffer is an io.Writer that keeps the most recent capacity bytes of
+// log output in a ring so they can be replayed later (e.g. after a crash).
+// Tee it next to the main writer:
+//
+//	rb, _ := logs.NewReplayBuffer(64 << 10)
+//	logs.Configure(logs.Config{Writer: logs.MultiLevelWriter(rb, os.Stdout)})
+//	defer rb.Replay(os.Stderr)
+type ReplayBuffer struct {
+	mu      sync.Mutex
+	buf     []byte
+	start   int  // index of the oldest byte
+	size    int  // bytes stored
+	partial bool // oldest stored line lost its beginning to the ring
+}
+
+// NewReplayBuffer returns an empty ReplayBuffer holding up to capacity bytes.
+func NewReplayBuffer(capacity int) (*ReplayBuffer, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("smplog: replay buffer capacity must be positive, got %d", capacity)
+	}
+	return &ReplayBuffer{buf: make([]byte, capacity)}, nil
+}
+
+// Write appends p, overwriting the oldest bytes once the buffer is full.
+func (r *ReplayBuffer) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := len(p)
+	capacity := len(r.buf)
+	if evict := r.size + n - capacity; evict > 0 {
+		// Inspect the last evicted byte before it is overwritten.
+		var last byte
+		if evict <= r.size {
+			last = r.buf[(r.start+evict-1)%capacity]
+		} else {
+			last = p[evict-1-r.size]
+		}
+		r.partial = last != '\n'
+	}
+	if n >= capacity {
+		copy(r.buf, p[n-capacity:])
+		r.start, r.size = 0, capacity
+		return n, nil
+	}
+	end := (r.start + r.size) % capacity
+	c := copy(r.buf[end:], p)
+	copy(r.buf, p[c:])
+	r.size += n
+	if r.size > capacity {
+		r.start = (r.start + r.size - capacity) % capacity
+		r.size = capacity
+	}
+	return n, nil
+}
+
+// Replay writes the stored events to w, oldest first.
+func (r *ReplayBuffer) Replay(w io.Writer) error {
+	_, err := w.Write(r.contents())
+	return err
+}
+
+// ReplayString returns the stored events, oldest first.
+func (r *ReplayBuffer) ReplayString() string {
+	return string(r.contents())
+}
+
+// LastN returns up to the last n stored lines, oldest first, without
+// trailing newlines.
+func (r *ReplayBuffer) LastN(n int) []string {
+	data := strings.TrimSuffix(string(r.contents()), "\n")
+	if n <= 0 || data == "" {
+		return nil
+	}
+	lines := strings.Split(data, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
+}
+
+// contents returns a copy of the stored bytes in write order, dropping the
+// oldest line if the ring overwrote its beginning.
+func (r *ReplayBuffer) contents() []byte {
+	out, partial := r.raw()
+	if partial {
+		i := bytes.IndexByte(out, '\n')
+		if i < 0 {
+			return out[:0]
+		}
+		out = out[i+1:]
+	}
+	return out
+}
+
+// raw returns a copy of every stored byte in write order and whether the
+// oldest line lost its beginning to the ring.
+func (r *ReplayBuffer) raw() ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]byte, 0, r.size)
+	end := r.start + r.size
+	if end <= len(r.buf) {
+		out = append(out, r.buf[r.start:end]...)
+	} else {
+		out = append(out, r.buf[r.start:]...)
+		out = append(out, r.buf[:end-len(r.buf)]...)
+	}
+	return out, r.partial
+}
+
+// reset discards the stored bytes, keeping the allocated ring.
+func (r *ReplayBuffer) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.start, r.size, r.partial = 0, 0, false
+}
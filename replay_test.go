@@ -0,0 +1,65 @@
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestReplayBufferKeepsMostRecentLines verifies capacity enforces ring behavior.
+func TestReplayBufferKeepsMostRecentLines(t *testing.T) {
+	if _, err := NewReplayBuffer(0); err == nil {
+		t.Fatal("expected error for zero capacity")
+	}
+
+	rb, err := NewReplayBuffer(14)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, line := range []string{"one\n", "two\n", "three\n"} {
+		rb.Write([]byte(line))
+	}
+	if got := rb.ReplayString(); got != "one\ntwo\nthree\n" {
+		t.Fatalf("expected all lines before wrapping, got %q", got)
+	}
+
+	rb.Write([]byte("four\n")) // evicts "one\n" and half of "two\n"
+	if got := rb.ReplayString(); got != "three\nfour\n" {
+		t.Fatalf("expected partial oldest line dropped, got %q", got)
+	}
+	rb.Write([]byte("ab\n")) // evicts the rest of "two\n", ending on a line boundary
+	if got := rb.ReplayString(); got != "three\nfour\nab\n" {
+		t.Fatalf("expected whole lines kept after boundary eviction, got %q", got)
+	}
+	if got := rb.LastN(1); len(got) != 1 || got[0] != "ab" {
+		t.Fatalf("expected last line, got %q", got)
+	}
+
+	var out bytes.Buffer
+	if err := rb.Replay(&out); err != nil {
+		t.Fatalf("unexpected replay error: %v", err)
+	}
+	if out.String() != rb.ReplayString() {
+		t.Fatalf("Replay and ReplayString differ: %q vs %q", out.String(), rb.ReplayString())
+	}
+}
+
+// TestReplayBufferTeesLogOutput verifies the buffer captures events via MultiLevelWriter.
+func TestReplayBufferTeesLogOutput(t *testing.T) {
+	var main bytes.Buffer
+	rb, _ := NewReplayBuffer(1024)
+
+	Configure(Config{Writer: MultiLevelWriter(rb, &main), Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	for _, msg := range []string{"a", "b", "c"} {
+		Info(msg)
+	}
+	if rb.ReplayString() != main.String() {
+		t.Fatalf("expected replay to match main output: %q vs %q", rb.ReplayString(), main.String())
+	}
+	last := rb.LastN(2)
+	if len(last) != 2 || !strings.Contains(last[0], `"b"`) || !strings.Contains(last[1], `"c"`) {
+		t.Fatalf("unexpected LastN(2): %q", last)
+	}
+}
@@ -0,0 +1,67 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+const defaultServeLogLines = 100
+
+// JSONLinesReader returns a reader over the events stored in buf as
+// newline-delimited JSON, oldest first. Lines that are not JSON (console
+// output) are encoded as JSON strings. The reader holds a snapshot taken
+// when it is created, so events logged afterwards are not included.
+//
+//	http.HandleFunc("/debug/logs", func(w http.ResponseWriter, r *http.Request) {
+//		w.Header().Set("Content-Type", "application/x-ndjson")
+//		io.Copy(w, logs.JSONLinesReader(rb))
+//	})
+func JSONLinesReader(buf *ReplayBuffer) io.Reader {
+	var out bytes.Buffer
+	for _, line := range bytes.Split(buf.contents(), []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		out.Write(jsonLineItem(line))
+		out.WriteByte('\n')
+	}
+	return &out
+}
+
+// ServeLogBuffer returns a handler that responds with the last n events in
+// buf as a JSON array, oldest first, where n comes from the "n" query
+// parameter (default 100). Events are copied out of buf before the response
+// is written, so slow clients never block logging.
+func ServeLogBuffer(buf *ReplayBuffer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := defaultServeLogLines
+		if raw := r.URL.Query().Get("n"); raw != "" {
+			v, err := strconv.Atoi(raw)
+			if err != nil || v < 0 {
+				http.Error(w, "smplog: n must be a non-negative integer", http.StatusBadRequest)
+				return
+			}
+			n = v
+		}
+		items := []json.RawMessage{}
+		for _, line := range buf.LastN(n) {
+			items = append(items, jsonLineItem([]byte(line)))
+		}
+		body, _ := json.Marshal(items)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+}
+
+// jsonLineItem returns line as a JSON value: unchanged if it is valid JSON,
+// otherwise as a JSON string.
+func jsonLineItem(line []byte) json.RawMessage {
+	if json.Valid(line) {
+		return line
+	}
+	s, _ := json.Marshal(string(line))
+	return s
+}
@@ -0,0 +1,83 @@
+package logs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func filledReplayBuffer(t *testing.T) *ReplayBuffer {
+	t.Helper()
+	rb, err := NewReplayBuffer(4096)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	l := New(rb)
+	l.Info().Msg("one")
+	l.Info().Msg("two")
+	rb.Write([]byte("console line\n"))
+	l.Info().Msg("three")
+	return rb
+}
+
+// TestJSONLinesReaderStreamsNDJSON verifies every stored line comes back as one JSON value.
+func TestJSONLinesReaderStreamsNDJSON(t *testing.T) {
+	rb := filledReplayBuffer(t)
+	var values []any
+	dec := json.NewDecoder(JSONLinesReader(rb))
+	for dec.More() {
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		values = append(values, v)
+	}
+	if len(values) != 4 || values[2] != "console line" {
+		t.Fatalf("unexpected values %v", values)
+	}
+}
+
+// TestServeLogBufferReturnsLastN verifies the n query parameter and JSON array response.
+func TestServeLogBufferReturnsLastN(t *testing.T) {
+	h := ServeLogBuffer(filledReplayBuffer(t))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/logs?n=2", nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("unexpected response %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	var items []any
+	if err := json.Unmarshal(rec.Body.Bytes(), &items); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(items) != 2 || items[0] != "console line" {
+		t.Fatalf("unexpected items %v", items)
+	}
+	if obj, ok := items[1].(map[string]any); !ok || obj["message"] != "three" {
+		t.Fatalf("unexpected last item %v", items[1])
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/logs", nil))
+	json.Unmarshal(rec.Body.Bytes(), &items)
+	if len(items) != 4 {
+		t.Fatalf("expected default n to return all 4 lines, got %d", len(items))
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/logs?n=abc", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for bad n, got %d", rec.Code)
+	}
+}
+
+// TestServeLogBufferEmpty verifies an empty buffer yields an empty array.
+func TestServeLogBufferEmpty(t *testing.T) {
+	rb, _ := NewReplayBuffer(64)
+	rec := httptest.NewRecorder()
+	ServeLogBuffer(rb).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Body.String() != "[]" {
+		t.Fatalf("expected [], got %q", rec.Body.String())
+	}
+}
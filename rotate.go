@@ -0,0 +1,273 @@
+package logs
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RotatingFileWriter is an append-mode log file that can be rotated in place.
+// Rotation renames the current file to <name>.1<ext> (e.g. app.log →
+// app.1.log), shifts older backups up by one, and reopens the original path.
+// Config.Files entries are opened as RotatingFileWriters.
+//
+// With LogFile.MaxSize set, a write that would grow the file past MaxSize
+// rotates first. With LogFile.Compress set, backups older than index 1 are
+// gzip-compressed in the background (app.2.log.gz, app.3.log.gz, …).
+type RotatingFileWriter struct {
+	mu   sync.Mutex
+	file LogFile
+	f    *os.File
+	size int64
+
+	// compressing tracks background compression so rotation never shifts a
+	// backup that is still being compressed.
+	compressing sync.WaitGroup
+}
+
+// NewRotatingFileWriter opens lf.Path for append/create.
+func NewRotatingFileWriter(lf LogFile) (*RotatingFileWriter, error) {
+	f, err := openLogFile(lf.Path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &RotatingFileWriter{file: lf, f: f, size: info.Size()}, nil
+}
+
+// Write appends p to the current file, rotating first if it would exceed
+// LogFile.MaxSize. A failed rotation is reported on stderr and p is still
+// appended to the current file.
+func (w *RotatingFileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return 0, os.ErrClosed
+	}
+	if limit := w.file.MaxSize; limit > 0 && w.size > 0 && w.size+int64(len(p)) > limit {
+		if err := w.rotateLocked(); err != nil {
+			fmt.Fprintf(os.Stderr, "smplog: rotate log file %q (%s): %v\n", w.file.Name, w.file.Path, err)
+			if w.f == nil {
+				return 0, err
+			}
+		}
+	}
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close closes the current file and waits for pending compression.
+// Later writes return os.ErrClosed.
+func (w *RotatingFileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.compressing.Wait()
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Close()
+	w.f = nil
+	return err
+}
+
+// rotate moves the current file to backup 1 and opens a fresh file.
+// With LogFile.MaxBackups > 0 the oldest backups beyond that count are
+// removed; then, with LogFile.MaxAge > 0, backups older than MaxAge are too.
+func (w *RotatingFileWriter) rotate() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return os.ErrClosed
+	}
+	return w.rotateLocked()
+}
+
+// rotateLocked rotates the current file. If any step fails the original
+// path is reopened for append, so writes keep going to the unrotated file.
+func (w *RotatingFileWriter) rotateLocked() error {
+	w.compressing.Wait()
+	path := w.file.Path
+	err := w.f.Close()
+	w.f = nil
+	if err == nil {
+		err = shiftBackups(path, w.file.MaxBackups)
+	}
+	if err == nil {
+		if err = os.Rename(path, backupPath(path, 1)); errors.Is(err, fs.ErrNotExist) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return w.reopenLocked(err)
+	}
+	f, err := openLogFile(path)
+	if err != nil {
+		return err
+	}
+	w.f, w.size = f, 0
+
+	// Expiry is best-effort cleanup: the new file is already open, so a
+	// backup that cannot be removed is reported without stopping writes.
+	var expireErr error
+	if w.file.MaxAge > 0 {
+		if err := removeExpiredBackups(path, time.Now().Add(-w.file.MaxAge)); err != nil {
+			expireErr = fmt.Errorf("remove expired backups: %w", err)
+		}
+	}
+	if w.file.Compress {
+		if src := backupPath(path, 2); fileExists(src) {
+			w.compressing.Add(1)
+			go func() {
+				defer w.compressing.Done()
+				if err := compressFile(src, w.file.CompressLevel); err != nil {
+					fmt.Fprintf(os.Stderr, "smplog: compress log backup %q: %v\n", src, err)
+				}
+			}()
+		}
+	}
+	return expireErr
+}
+
+// reopenLocked reopens the current path for append after a failed rotation
+// and returns cause, joined with the reopen error if that fails too.
+func (w *RotatingFileWriter) reopenLocked(cause error) error {
+	f, err := openLogFile(w.file.Path)
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+	w.f = f
+	if info, err := f.Stat(); err == nil {
+		w.size = info.Size()
+	}
+	return cause
+}
+
+// shiftBackups renames backup n to n+1 for every existing backup, plain or
+// gzip-compressed, dropping those that would exceed maxBackups (0 keeps all).
+func shiftBackups(path string, maxBackups int) error {
+	n := 0
+	for backupExists(path, n+1) {
+		n++
+	}
+	for ; n > 0; n-- {
+		for _, suffix := range []string{"", ".gz"} {
+			src := backupPath(path, n) + suffix
+			if !fileExists(src) {
+				continue
+			}
+			var err error
+			if maxBackups > 0 && n >= maxBackups {
+				err = os.Remove(src)
+			} else {
+				err = os.Rename(src, backupPath(path, n+1)+suffix)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeExpiredBackups deletes backups, plain or gzip-compressed, last
+// modified before cutoff.
+func removeExpiredBackups(path string, cutoff time.Time) error {
+	for n := 1; backupExists(path, n); n++ {
+		for _, suffix := range []string{"", ".gz"} {
+			p := backupPath(path, n) + suffix
+			info, err := os.Stat(p)
+			if err != nil || !info.ModTime().Before(cutoff) {
+				continue
+			}
+			if err := os.Remove(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func backupExists(path string, n int) bool {
+	p := backupPath(path, n)
+	return fileExists(p) || fileExists(p+".gz")
+}
+
+// compressFile gzips src to src+".gz" at level (0 = gzip.DefaultCompression)
+// and removes src on success.
+func compressFile(src string, level int) (err error) {
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	dst := src + ".gz"
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			out.Close()
+			os.Remove(dst)
+		}
+	}()
+
+	zw, err := gzip.NewWriterLevel(out, level)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(zw, in); err != nil {
+		return err
+	}
+	if err = zw.Close(); err != nil {
+		return err
+	}
+	if err = out.Close(); err != nil {
+		return err
+	}
+	in.Close()
+	return os.Remove(src)
+}
+
+// backupPath returns the path of backup n for path: logs/app.log → logs/app.<n>.log.
+func backupPath(path string, n int) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s.%d%s", strings.TrimSuffix(path, ext), n, ext)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func openLogFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+}
+
+// rotateFiles rotates every open Config.Files writer, reporting failures on
+// stderr like applyFiles does.
+func rotateFiles() {
+	filesMu.RLock()
+	defer filesMu.RUnlock()
+	for name, w := range openFiles {
+		if err := w.rotate(); err != nil {
+			fmt.Fprintf(os.Stderr, "smplog: rotate log file %q (%s): %v\n", name, w.file.Path, err)
+		}
+	}
+}
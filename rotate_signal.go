@@ -0,0 +1,42 @@
+//go:build !windows
+
+package logs
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+)
+
+// FileRotateOnSignal installs a handler that rotates every Config.Files log
+// file each time sig is received (conventionally SIGUSR1):
+//
+//	stop, _ := logs.FileRotateOnSignal(syscall.SIGUSR1)
+//	defer stop()
+//
+// Each call installs its own handler, so handlers for different signals
+// stack; the returned func removes only this one.
+func FileRotateOnSignal(sig os.Signal) (func(), error) {
+	sigs := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigs, sig)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-sigs:
+				rotateFiles()
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(sigs)
+			close(done)
+		})
+	}, nil
+}
@@ -0,0 +1,53 @@
+//go:build unix
+
+package logs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// TestFileRotateOnSignalRotatesFiles verifies the signal moves the log to a backup and reopens it.
+func TestFileRotateOnSignalRotatesFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	Configure(Config{Files: []LogFile{{Name: "app", Path: path}}})
+	t.Cleanup(func() {
+		Close()
+		Configure(DefaultConfig())
+	})
+
+	stop, err := FileRotateOnSignal(syscall.SIGUSR1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(stop)
+
+	WriteFile(At(InfoLevel, "before"), "app")
+	if err := syscall.Kill(os.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("send signal: %v", err)
+	}
+
+	backup := filepath.Join(filepath.Dir(path), "app.1.log")
+	deadline := time.Now().Add(2 * time.Second)
+	for !fileExists(backup) {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for rotated backup")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	WriteFile(At(InfoLevel, "after"), "app")
+
+	old, _ := os.ReadFile(backup)
+	cur, _ := os.ReadFile(path)
+	if !strings.Contains(string(old), "before") || strings.Contains(string(old), "after") {
+		t.Fatalf("unexpected backup contents: %q", old)
+	}
+	if !strings.Contains(string(cur), "after") || strings.Contains(string(cur), "before") {
+		t.Fatalf("unexpected current file contents: %q", cur)
+	}
+}
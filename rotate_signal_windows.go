@@ -0,0 +1,11 @@
+//go:build windows
+
+package logs
+
+import "os"
+
+// FileRotateOnSignal is a no-op on Windows, which has no rotation signals.
+// It returns a no-op stop func and a nil error.
+func FileRotateOnSignal(sig os.Signal) (func(), error) {
+	return func() {}, nil
+}
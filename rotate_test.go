@@ -0,0 +1,182 @@
+package logs
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestRotateKeepsMaxBackups verifies rotation shifts backups and drops those past MaxBackups.
+func TestRotateKeepsMaxBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	w, err := NewRotatingFileWriter(LogFile{Name: "app", Path: path, MaxBackups: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { w.Close() })
+
+	for _, gen := range []string{"a", "b", "c", "d"} {
+		w.Write([]byte(gen))
+		if err := w.rotate(); err != nil {
+			t.Fatalf("rotate: %v", err)
+		}
+	}
+
+	for n, want := range map[int]string{1: "d", 2: "c"} {
+		got, err := os.ReadFile(backupPath(path, n))
+		if err != nil || string(got) != want {
+			t.Fatalf("backup %d: got %q (%v), want %q", n, got, err, want)
+		}
+	}
+	if fileExists(backupPath(path, 3)) {
+		t.Fatal("expected backups beyond MaxBackups to be removed")
+	}
+	if got, _ := os.ReadFile(path); len(got) != 0 {
+		t.Fatalf("expected fresh current file, got %q", got)
+	}
+}
+
+// TestRotateFailureKeepsWriting verifies a failed size-triggered rotation leaves the file writable.
+func TestRotateFailureKeepsWriting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	// A non-empty directory squatting on backup 1 cannot be removed or replaced.
+	if err := os.MkdirAll(filepath.Join(backupPath(path, 1), "keep"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewRotatingFileWriter(LogFile{Name: "app", Path: path, MaxSize: 8, MaxBackups: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { w.Close() })
+
+	for _, line := range []string{"line 01\n", "line 02\n", "line 03\n"} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatalf("write after failed rotation: %v", err)
+		}
+	}
+	if got, _ := os.ReadFile(path); string(got) != "line 01\nline 02\nline 03\n" {
+		t.Fatalf("expected every line in the unrotated file, got %q", got)
+	}
+}
+
+// TestRotateCompressesOlderBackups verifies size-triggered rotation gzips backups past index 1.
+func TestRotateCompressesOlderBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	w, err := NewRotatingFileWriter(LogFile{
+		Name:          "app",
+		Path:          path,
+		MaxSize:       16,
+		Compress:      true,
+		CompressLevel: gzip.BestSpeed,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, line := range []string{"first line 0001\n", "second line 002\n", "third line 0003\n"} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil { // waits for background compression
+		t.Fatalf("close: %v", err)
+	}
+
+	if got, _ := os.ReadFile(backupPath(path, 1)); string(got) != "second line 002\n" {
+		t.Fatalf("expected plain backup 1, got %q", got)
+	}
+	if fileExists(backupPath(path, 2)) {
+		t.Fatal("expected uncompressed backup 2 to be removed")
+	}
+	f, err := os.Open(backupPath(path, 2) + ".gz")
+	if err != nil {
+		t.Fatalf("expected gzip backup: %v", err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("invalid gzip backup: %v", err)
+	}
+	if got, _ := io.ReadAll(zr); string(got) != "first line 0001\n" {
+		t.Fatalf("unexpected gzip contents: %q", got)
+	}
+}
+
+// TestRotateRemovesBackupsOlderThanMaxAge verifies expired backups are deleted after rotation.
+func TestRotateRemovesBackupsOlderThanMaxAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	old := time.Now().Add(-2 * time.Hour)
+	for _, b := range []struct {
+		path  string
+		mtime time.Time
+	}{
+		{backupPath(path, 1), time.Now()},
+		{backupPath(path, 2), old},
+		{backupPath(path, 3) + ".gz", old},
+	} {
+		if err := os.WriteFile(b.path, []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(b.path, b.mtime, b.mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w, err := NewRotatingFileWriter(LogFile{Name: "app", Path: path, MaxAge: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { w.Close() })
+	w.Write([]byte("current"))
+	if err := w.rotate(); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	for _, p := range []string{backupPath(path, 1), backupPath(path, 2)} {
+		if !fileExists(p) {
+			t.Fatalf("expected recent backup %s to be kept", p)
+		}
+	}
+	for _, p := range []string{backupPath(path, 3), backupPath(path, 4) + ".gz"} {
+		if fileExists(p) {
+			t.Fatalf("expected expired backup %s to be deleted", p)
+		}
+	}
+}
+
+// TestRotateExpiryFailureKeepsNewFile verifies a backup that cannot be expired does not stop writes.
+func TestRotateExpiryFailureKeepsNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	// A non-empty directory cannot be removed by expiry.
+	stale := backupPath(path, 1)
+	if err := os.MkdirAll(filepath.Join(stale, "keep"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewRotatingFileWriter(LogFile{Name: "app", Path: path, MaxAge: time.Hour})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { w.Close() })
+	w.Write([]byte("before"))
+	if err := w.rotate(); err == nil {
+		t.Fatal("expected the expiry error to be reported")
+	}
+
+	if _, err := w.Write([]byte("after")); err != nil {
+		t.Fatalf("write after failed expiry: %v", err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != "after" {
+		t.Fatalf("expected the new file to receive writes, got %q", got)
+	}
+	if got, _ := os.ReadFile(backupPath(path, 1)); string(got) != "before" {
+		t.Fatalf("expected the rotated backup, got %q", got)
+	}
+}
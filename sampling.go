@@ -0,0 +1,68 @@
+package logs
+
+import (
+	"io"
+	"math/rand"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// SamplingWriter passes each write to w with probability rate and discards
+// the rest, counting them in LogMetrics().EventsDropped. zerolog writes one
+// event per Write call, so the decision is per event. Config.SamplingRate
+// installs one just inside the metrics writer, ahead of JSON rewrites and
+// console formatting, so dropped events skip both.
+type SamplingWriter struct {
+	w    io.Writer
+	rate float64
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewSamplingWriter returns a SamplingWriter forwarding to w. A rate >= 1
+// keeps every write and a rate <= 0 drops every write. A nil rng uses the
+// math/rand top-level source.
+func NewSamplingWriter(w io.Writer, rate float64, rng *rand.Rand) *SamplingWriter {
+	return &SamplingWriter{w: w, rate: rate, rng: rng}
+}
+
+// Write forwards p to the destination when sampled. A dropped write reports
+// len(p) and no error.
+func (s *SamplingWriter) Write(p []byte) (int, error) {
+	if !s.sample() {
+		metricsDropped.Add(1)
+		return len(p), nil
+	}
+	return s.w.Write(p)
+}
+
+// WriteLevel is Write for zerolog.LevelWriter destinations: a sampled write
+// is forwarded with its level.
+func (s *SamplingWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	lw, ok := s.w.(zerolog.LevelWriter)
+	if !ok {
+		return s.Write(p)
+	}
+	if !s.sample() {
+		metricsDropped.Add(1)
+		return len(p), nil
+	}
+	return lw.WriteLevel(level, p)
+}
+
+func (s *SamplingWriter) sample() bool {
+	if s.rate >= 1 {
+		return true
+	}
+	if s.rate <= 0 {
+		return false
+	}
+	if s.rng == nil {
+		return rand.Float64() < s.rate
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rng.Float64() < s.rate
+}
@@ -0,0 +1,90 @@
+package logs
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// TestSamplingRatePassesAboutHalf verifies SamplingRate 0.5 keeps roughly half of 10,000 events.
+func TestSamplingRatePassesAboutHalf(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{
+		Writer:       &out,
+		Level:        InfoLevel,
+		Bypass:       true,
+		SamplingRate: 0.5,
+		SamplingRng:  rand.New(rand.NewSource(1)),
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	for range 10000 {
+		Zerolog().Info().Msg("event")
+	}
+
+	n := strings.Count(out.String(), "\n")
+	if n < 4500 || n > 5500 {
+		t.Fatalf("expected ~5000 events, got %d", n)
+	}
+	if len(decodeLines(t, out.String())) != n {
+		t.Fatal("expected whole events, not partial lines")
+	}
+}
+
+// TestSamplingRateDefaultKeepsAll verifies zero and >= 1 rates install no sampling.
+func TestSamplingRateDefaultKeepsAll(t *testing.T) {
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	for _, rate := range []float64{0, 1, 2} {
+		var out bytes.Buffer
+		Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true, SamplingRate: rate})
+		for range 100 {
+			Zerolog().Info().Msg("event")
+		}
+		if n := strings.Count(out.String(), "\n"); n != 100 {
+			t.Fatalf("rate %v: expected all 100 events, got %d", rate, n)
+		}
+	}
+}
+
+// TestSamplingWriterBounds verifies rate <= 0 drops every write and reports success.
+func TestSamplingWriterBounds(t *testing.T) {
+	var out bytes.Buffer
+	w := NewSamplingWriter(&out, 0, nil)
+	if n, err := w.Write([]byte("x\n")); n != 2 || err != nil {
+		t.Fatalf("expected dropped write to report success, got %d, %v", n, err)
+	}
+	if out.Len() != 0 {
+		t.Fatalf("expected nothing written, got %q", out.String())
+	}
+	if _, _ = NewSamplingWriter(&out, 1, nil).Write([]byte("y\n")); out.String() != "y\n" {
+		t.Fatalf("expected rate 1 to pass through, got %q", out.String())
+	}
+}
+
+// TestSamplingRateForwardsWriteLevel verifies sampled events reach a LevelWriter destination with their level.
+func TestSamplingRateForwardsWriteLevel(t *testing.T) {
+	var out levelRecorder
+	Configure(Config{
+		Writer:       &out,
+		Level:        InfoLevel,
+		Bypass:       true,
+		SamplingRate: 0.5,
+		SamplingRng:  rand.New(rand.NewSource(1)),
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	for range 100 {
+		Warn("event")
+	}
+
+	n := strings.Count(out.String(), "\n")
+	if n == 0 || len(out.levels) != n {
+		t.Fatalf("expected a WriteLevel call per sampled event, got %d calls for %d events", len(out.levels), n)
+	}
+	for _, level := range out.levels {
+		if level != WarnLevel {
+			t.Fatalf("unexpected level %v", level)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package logs
+
+import "sync"
+
+// ScopedConfig applies temporary overrides to the global config and restores
+// a saved parent on Close. It implements io.Closer, so a test can write:
+//
+//	scope := logs.NewScopedConfig(logs.Configured())
+//	defer scope.Close()
+//	scope.Apply(logs.Config{Writer: &buf, Bypass: true})
+type ScopedConfig struct {
+	mu      sync.Mutex
+	parent  Config
+	current Config
+	closed  bool
+}
+
+// NewScopedConfig returns a scope that restores parent on Close. The global
+// config is not changed until Apply is called.
+func NewScopedConfig(parent Config) *ScopedConfig {
+	return &ScopedConfig{parent: parent, current: parent}
+}
+
+// Apply merges the non-zero fields of cfg onto the scope's config with
+// MergeFrom and installs the result with Configure. Successive calls
+// accumulate. Like MergeFrom, zero values in cfg are ignored, so Apply
+// cannot turn a bool off or select DebugLevel; set those on the parent
+// instead. Apply is a no-op after Close.
+func (s *ScopedConfig) Apply(cfg Config) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.current = s.current.MergeFrom(cfg)
+	Configure(s.current)
+}
+
+// Close restores the parent config. Later calls are no-ops. It always
+// returns nil.
+func (s *ScopedConfig) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	Configure(s.parent)
+	return nil
+}
+
+// WithScopedConfig merges cfg onto the current config for the duration of
+// fn and restores the previous config afterwards, even if fn panics.
+func WithScopedConfig(cfg Config, fn func()) {
+	scope := NewScopedConfig(Configured())
+	defer scope.Close()
+	scope.Apply(cfg)
+	fn()
+}
@@ -0,0 +1,68 @@
+package logs
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestScopedConfigApplyAndClose(t *testing.T) {
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	Configure(Config{Level: WarnLevel, NoColor: true})
+	parent := Configured()
+
+	var out bytes.Buffer
+	scope := NewScopedConfig(parent)
+	var _ io.Closer = scope
+	scope.Apply(Config{Writer: &out, Bypass: true})
+	scope.Apply(Config{Level: InfoLevel})
+
+	got := Configured()
+	if got.Level != InfoLevel || !got.Bypass || got.Writer != &out || !got.NoColor {
+		t.Fatalf("expected accumulated overrides on parent, got %+v", got)
+	}
+	Zerolog().Info().Msg("scoped")
+	if !strings.Contains(out.String(), `"message":"scoped"`) {
+		t.Fatalf("expected scoped writer to receive output, got %q", out.String())
+	}
+
+	if err := scope.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	got = Configured()
+	if got.Level != WarnLevel || got.Bypass || got.Writer != parent.Writer {
+		t.Fatalf("expected parent restored, got %+v", got)
+	}
+
+	Configure(Config{Level: ErrorLevel})
+	_ = scope.Close()
+	scope.Apply(Config{Level: TraceLevel})
+	if Configured().Level != ErrorLevel {
+		t.Fatal("expected Close and Apply to be no-ops after Close")
+	}
+}
+
+func TestWithScopedConfigRestoresAfterPanic(t *testing.T) {
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+	Configure(Config{Level: WarnLevel})
+
+	var out bytes.Buffer
+	WithScopedConfig(Config{Writer: &out, Level: InfoLevel, Bypass: true}, func() {
+		Zerolog().Info().Msg("inside")
+	})
+	if !strings.Contains(out.String(), "inside") {
+		t.Fatalf("expected output inside scope, got %q", out.String())
+	}
+	if Configured().Level != WarnLevel || Configured().Bypass {
+		t.Fatalf("expected config restored, got %+v", Configured())
+	}
+
+	func() {
+		defer func() { _ = recover() }()
+		WithScopedConfig(Config{Level: ErrorLevel}, func() { panic("boom") })
+	}()
+	if Configured().Level != WarnLevel {
+		t.Fatalf("expected config restored after panic, got level %v", Configured().Level)
+	}
+}
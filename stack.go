@@ -0,0 +1,44 @@
+package logs
+
+import (
+	"runtime"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const maxStackDepth = 64
+
+// CallStack returns the current goroutine's call stack, one frame per line
+// as "<caller> <function>", innermost first. Callers are rendered with
+// zerolog.CallerMarshalFunc (see SetCallerMarshalFunc), matching the caller
+// field. skip 0 starts at the function calling CallStack; each increment
+// skips one more frame.
+func CallStack(skip int) string {
+	return callStack(skip + 3)
+}
+
+// LogStack logs msg at level on the active logger with a "stack" field
+// holding CallStack from LogStack's caller.
+func LogStack(level Level, msg string) {
+	Zerolog().WithLevel(level).Str("stack", callStack(3)).Msg(msg)
+}
+
+// callStack formats the stack above runtime.Callers' skip frames (0 is
+// runtime.Callers, 1 is callStack).
+func callStack(skip int) string {
+	pcs := make([]uintptr, maxStackDepth)
+	pcs = pcs[:runtime.Callers(skip, pcs)]
+	frames := runtime.CallersFrames(pcs)
+	var lines []string
+	for {
+		f, more := frames.Next()
+		if f.Function != "runtime.goexit" {
+			lines = append(lines, zerolog.CallerMarshalFunc(f.PC, f.File, f.Line)+" "+f.Function)
+		}
+		if !more {
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,47 @@
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func stackHelper() string { return CallStack(0) }
+
+func stackSkipHelper() string { return CallStack(1) }
+
+// TestCallStackStartsAtCaller verifies skip 0 begins at CallStack's caller and skip 1 one frame up.
+func TestCallStackStartsAtCaller(t *testing.T) {
+	lines := strings.Split(stackHelper(), "\n")
+	if !strings.HasSuffix(lines[0], ".stackHelper") || !strings.Contains(lines[0], "stack_test.go:") {
+		t.Fatalf("expected first frame stackHelper, got %q", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], ".TestCallStackStartsAtCaller") {
+		t.Fatalf("expected second frame to be the test, got %q", lines[1])
+	}
+	first := strings.Split(stackSkipHelper(), "\n")[0]
+	if !strings.HasSuffix(first, ".TestCallStackStartsAtCaller") {
+		t.Fatalf("expected skip 1 to start at the test, got %q", first)
+	}
+	if strings.Contains(stackHelper(), "runtime.goexit") {
+		t.Fatal("expected runtime.goexit to be omitted")
+	}
+}
+
+// TestLogStackAddsStackField verifies LogStack emits msg at level with the caller's stack.
+func TestLogStackAddsStackField(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	LogStack(ErrorLevel, "stack-test")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 1 || lines[0]["level"] != "error" || lines[0]["message"] != "stack-test" {
+		t.Fatalf("unexpected output %v", lines)
+	}
+	stack, _ := lines[0]["stack"].(string)
+	if !strings.HasSuffix(strings.Split(stack, "\n")[0], ".TestLogStackAddsStackField") {
+		t.Fatalf("expected stack to start at the test, got %q", stack)
+	}
+}
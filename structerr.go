@@ -0,0 +1,77 @@
+package logs
+
+import (
+	"maps"
+	"slices"
+)
+
+// StructuredError is an error carrying a numeric code and log fields, for
+// RPC-style services that log errors far from where they were created:
+//
+//	return logs.NewStructuredError(404, "user not found", map[string]any{"user_id": id})
+//	...
+//	var serr *logs.StructuredError
+//	if errors.As(err, &serr) {
+//		logs.LogStructuredError(serr)
+//	}
+type StructuredError struct {
+	code   int
+	msg    string
+	fields map[string]any
+	err    error
+}
+
+// NewStructuredError returns a StructuredError. fields is copied.
+func NewStructuredError(code int, msg string, fields map[string]any) *StructuredError {
+	return &StructuredError{code: code, msg: msg, fields: maps.Clone(fields)}
+}
+
+// WrapStructuredError is NewStructuredError with err as the cause, reported
+// by Unwrap and logged as a "cause" field.
+func WrapStructuredError(err error, code int, msg string, fields map[string]any) *StructuredError {
+	e := NewStructuredError(code, msg, fields)
+	e.err = err
+	return e
+}
+
+// Error returns the message.
+func (e *StructuredError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the wrapped cause, if any, for errors.Is and errors.As.
+func (e *StructuredError) Unwrap() error {
+	return e.err
+}
+
+// Code returns the error code.
+func (e *StructuredError) Code() int {
+	return e.code
+}
+
+// Fields returns a copy of the error's fields.
+func (e *StructuredError) Fields() map[string]any {
+	return maps.Clone(e.fields)
+}
+
+// MarshalZerologObject adds "code", the fields in key order and, for a
+// wrapped error, "cause", so the error can be embedded in any event with
+// EmbedObject or Object.
+func (e *StructuredError) MarshalZerologObject(evt *Event) {
+	evt.Int("code", e.code)
+	for _, key := range slices.Sorted(maps.Keys(e.fields)) {
+		appendEventField(evt, key, e.fields[key])
+	}
+	if e.err != nil {
+		evt.Str("cause", e.err.Error())
+	}
+}
+
+// LogStructuredError logs err at error level with its message and all of
+// its fields expanded at the top level. It is a no-op for a nil err.
+func LogStructuredError(err *StructuredError) {
+	if err == nil {
+		return
+	}
+	Zerolog().Error().EmbedObject(err).Msg(err.Error())
+}
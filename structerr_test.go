@@ -0,0 +1,52 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// TestLogStructuredErrorExpandsFields verifies code, fields and message appear at the top level.
+func TestLogStructuredErrorExpandsFields(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	fields := map[string]any{"user_id": "u1", "attempt": 2}
+	err := NewStructuredError(404, "user not found", fields)
+	fields["user_id"] = "mutated"
+	LogStructuredError(err)
+	LogStructuredError(nil)
+
+	want := `{"level":"error","code":404,"attempt":2,"user_id":"u1","message":"user not found"}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+	if err.Error() != "user not found" || err.Code() != 404 || err.Fields()["user_id"] != "u1" {
+		t.Fatalf("unexpected accessors: %q %d %v", err.Error(), err.Code(), err.Fields())
+	}
+	var _ LogObjectMarshaler = err
+}
+
+// TestWrapStructuredErrorKeepsCause verifies errors.Is/As and the logged cause field.
+func TestWrapStructuredErrorKeepsCause(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var err error = WrapStructuredError(io.ErrUnexpectedEOF, 502, "upstream read failed", nil)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatal("expected errors.Is to find the cause")
+	}
+	var serr *StructuredError
+	if !errors.As(err, &serr) || serr.Code() != 502 {
+		t.Fatalf("expected errors.As to find the structured error, got %v", serr)
+	}
+
+	LogStructuredError(serr)
+	line := decodeLines(t, out.String())[0]
+	if line["code"] != float64(502) || line["cause"] != io.ErrUnexpectedEOF.Error() || line["message"] != "upstream read failed" {
+		t.Fatalf("unexpected line %v", line)
+	}
+}
@@ -0,0 +1,159 @@
+package logs
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// StructFields returns a LogObjectMarshaler that adds the exported fields of
+// struct v (or a pointer to one) as event fields. Strings, bools, integers
+// and floats use typed fields; everything else, including embedded and
+// nested structs, goes through Interface. A json tag name overrides the
+// field name; `log:"-"` or `json:"-"` skips the field.
+//
+//	logs.Zerolog().Info().EmbedObject(logs.StructFields(req)).Msg("request")
+//
+// Non-struct values are added under a single "value" field.
+func StructFields(v any) LogObjectMarshaler {
+	return structMarshaler{v: v}
+}
+
+// StructFieldsDeep is StructFields with embedded structs flattened into the
+// parent and nested struct fields written as nested objects. Structs that
+// implement json.Marshaler (e.g. time.Time) are still written via Interface.
+// A pointer back to a struct already being written is logged as "<cycle>".
+func StructFieldsDeep(v any) LogObjectMarshaler {
+	return structMarshaler{v: v, deep: true}
+}
+
+// cyclePlaceholder is written in place of a nested struct pointer that is
+// already being marshaled further up.
+const cyclePlaceholder = "<cycle>"
+
+type structMarshaler struct {
+	v    any
+	deep bool
+	// path holds the struct pointers being marshaled by enclosing objects.
+	path []uintptr
+}
+
+func (m structMarshaler) MarshalZerologObject(e *Event) {
+	rv := reflect.ValueOf(m.v)
+	path := m.path
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return
+		}
+		path, _ = pathWith(path, rv)
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		e.Interface("value", m.v)
+		return
+	}
+	appendStructFields(e, rv, m.deep, path)
+}
+
+// pathWith returns path extended with fv's address if fv is a pointer, and
+// false if that address is already on path.
+func pathWith(path []uintptr, fv reflect.Value) ([]uintptr, bool) {
+	if fv.Kind() != reflect.Pointer {
+		return path, true
+	}
+	p := fv.Pointer()
+	if slices.Contains(path, p) {
+		return path, false
+	}
+	return append(path[:len(path):len(path)], p), true
+}
+
+// appendStructFields adds the fields of struct value rv to e. path holds the
+// pointers of the structs being marshaled, to cut reference cycles.
+func appendStructFields(e *Event, rv reflect.Value, deep bool, path []uintptr) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		name, ok := structFieldName(f)
+		if !ok {
+			continue
+		}
+		fv := rv.Field(i)
+		if deep && f.Anonymous && f.Tag.Get("json") == "" && isNestedStruct(fv) {
+			if inner, ok := pathWith(path, fv); ok {
+				appendStructFields(e, reflect.Indirect(fv), deep, inner)
+			} else if f.IsExported() {
+				e.Str(name, cyclePlaceholder)
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if deep && isNestedStruct(fv) {
+			if _, ok := pathWith(path, fv); !ok {
+				e.Str(name, cyclePlaceholder)
+				continue
+			}
+			e.Object(name, structMarshaler{v: fv.Interface(), deep: deep, path: path})
+			continue
+		}
+		appendStructValue(e, name, fv)
+	}
+}
+
+// structFieldName returns the log key for f and false if f is skipped.
+// Unexported embedded structs are kept so StructFieldsDeep can flatten them.
+func structFieldName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() && !f.Anonymous {
+		return "", false
+	}
+	if f.Tag.Get("log") == "-" {
+		return "", false
+	}
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, true
+	}
+	return f.Name, true
+}
+
+// isNestedStruct reports whether fv is a struct (or non-nil pointer to one)
+// that StructFieldsDeep should descend into.
+func isNestedStruct(fv reflect.Value) bool {
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return false
+		}
+		fv = fv.Elem()
+	}
+	if fv.Kind() != reflect.Struct {
+		return false
+	}
+	t := fv.Type()
+	return !t.Implements(jsonMarshalerType) && !reflect.PointerTo(t).Implements(jsonMarshalerType)
+}
+
+// appendStructValue adds fv under key with a typed field where possible.
+func appendStructValue(e *Event, key string, fv reflect.Value) {
+	switch fv.Kind() {
+	case reflect.String:
+		e.Str(key, fv.String())
+	case reflect.Bool:
+		e.Bool(key, fv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.Int64(key, fv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		e.Uint64(key, fv.Uint())
+	case reflect.Float32, reflect.Float64:
+		e.Float64(key, fv.Float())
+	default:
+		e.Interface(key, fv.Interface())
+	}
+}
@@ -0,0 +1,84 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+type structFieldsBase struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind,omitempty"`
+}
+
+type structFieldsAddr struct {
+	City string
+}
+
+type structFieldsUser struct {
+	structFieldsBase
+	Name    string
+	Admin   bool
+	Score   float64 `json:"score"`
+	Secret  string  `log:"-"`
+	Created time.Time
+	Addr    structFieldsAddr
+	Tags    []string
+	private int
+}
+
+// TestStructFieldsMarshalsMixedTypes verifies exported fields, tags and embedded structs.
+func TestStructFieldsMarshalsMixedTypes(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	u := structFieldsUser{
+		structFieldsBase: structFieldsBase{ID: 7, Kind: "user"},
+		Name:             "ada",
+		Admin:            true,
+		Score:            1.5,
+		Secret:           "hunter2",
+		Created:          time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Addr:             structFieldsAddr{City: "London"},
+		Tags:             []string{"a", "b"},
+		private:          1,
+	}
+
+	Zerolog().Info().EmbedObject(StructFields(&u)).Msg("")
+	Zerolog().Info().EmbedObject(StructFieldsDeep(u)).Msg("")
+	Zerolog().Info().EmbedObject(StructFields(42)).Msg("")
+
+	want := `{"level":"info","Name":"ada","Admin":true,"score":1.5,"Created":"2024-01-02T03:04:05Z","Addr":{"City":"London"},"Tags":["a","b"]}` + "\n" +
+		`{"level":"info","id":7,"kind":"user","Name":"ada","Admin":true,"score":1.5,"Created":"2024-01-02T03:04:05Z","Addr":{"City":"London"},"Tags":["a","b"]}` + "\n" +
+		`{"level":"info","value":42}` + "\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
+
+type structFieldsCycle struct {
+	Name string
+	Next *structFieldsCycle
+}
+
+// TestStructFieldsDeepStopsAtCycles verifies a self-referential struct logs a placeholder instead of recursing.
+func TestStructFieldsDeepStopsAtCycles(t *testing.T) {
+	var out bytes.Buffer
+
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	a := &structFieldsCycle{Name: "a"}
+	b := &structFieldsCycle{Name: "b", Next: a}
+	a.Next = b
+	Zerolog().Info().Object("n", StructFieldsDeep(a)).Msg("")
+
+	lines := decodeLines(t, out.String())
+	n, _ := lines[0]["n"].(map[string]any)
+	next, _ := n["Next"].(map[string]any)
+	if n["Name"] != "a" || next["Name"] != "b" || next["Next"] != "<cycle>" {
+		t.Fatalf("expected the cycle back to a to be cut, got %v", lines[0]["n"])
+	}
+}
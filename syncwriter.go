@@ -0,0 +1,27 @@
+package logs
+
+import (
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// NewSyncLogger returns a logger built from the active configuration whose
+// final writer is wrapped in zerolog.SyncWriter, so concurrent events are
+// written one at a time. Plain zerolog loggers only guarantee that each event
+// is a single Write call; writers that are not safe for concurrent use (a
+// bytes.Buffer, some custom sinks) need this serialization. The logger is a
+// snapshot; call NewSyncLogger again after Configure.
+func NewSyncLogger() Logger {
+	cfg := Configured()
+	cfg.Writer = zerolog.SyncWriter(cfg.Writer)
+	cfg.ConfigureZerolog = nil
+	return buildLogger(cfg)
+}
+
+// Synchronize returns a copy of l, with the same level, fields and hooks,
+// that writes to w wrapped in zerolog.SyncWriter. zerolog does not expose a
+// logger's writer, so pass the one l was built with. See NewSyncLogger.
+func Synchronize(l Logger, w io.Writer) Logger {
+	return l.Output(zerolog.SyncWriter(w))
+}
@@ -0,0 +1,68 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// unsafeWriter appends to a plain buffer with no locking; concurrent writes
+// interleave or race unless serialized.
+type unsafeWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *unsafeWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+// logConcurrently emits 10,000 events from 100 goroutines and checks every
+// line arrived intact. Run with -race to detect unsynchronized writes.
+func logConcurrently(t *testing.T, l Logger, w *unsafeWriter) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for g := 0; g < 100; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				l.Info().Int("goroutine", g).Int("i", i).Msg("concurrent")
+			}
+		}()
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSpace(w.buf.String()), "\n")
+	if len(lines) != 10000 {
+		t.Fatalf("expected 10000 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if !json.Valid([]byte(line)) {
+			t.Fatalf("corrupted line %q", line)
+		}
+	}
+}
+
+// TestNewSyncLoggerSerializesWrites verifies the global-config sync logger under concurrency.
+func TestNewSyncLoggerSerializesWrites(t *testing.T) {
+	w := &unsafeWriter{}
+	Configure(Config{Writer: w, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	logConcurrently(t, NewSyncLogger(), w)
+}
+
+// TestSynchronizeSerializesWrites verifies Synchronize keeps fields and level while serializing writes.
+func TestSynchronizeSerializesWrites(t *testing.T) {
+	w := &unsafeWriter{}
+	base := New(w).Level(InfoLevel).With().Str("svc", "api").Logger()
+	l := Synchronize(base, w)
+	l.Debug().Msg("filtered")
+
+	logConcurrently(t, l, w)
+	if !strings.Contains(strings.SplitN(w.buf.String(), "\n", 2)[0], `"svc":"api"`) {
+		t.Fatalf("expected context fields kept, got %q", w.buf.String()[:80])
+	}
+}
@@ -0,0 +1,64 @@
+//go:build !windows && !plan9
+
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/syslog"
+
+	"github.com/rs/zerolog"
+)
+
+// SyslogWriter dials a syslog daemon (see syslog.Dial; network "" uses the
+// local socket) and returns a writer that forwards each JSON log line as the
+// syslog message, with the priority taken from the line's level field:
+// trace/debug → LOG_DEBUG, info → LOG_INFO, warn → LOG_WARNING,
+// error → LOG_ERR, fatal → LOG_CRIT, panic → LOG_EMERG. Lines without a
+// recognizable level use LOG_INFO. Use it with Bypass so lines are JSON.
+func SyslogWriter(network, addr, tag string) (io.Writer, error) {
+	w, err := syslog.Dial(network, addr, syslog.LOG_USER|syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, err
+	}
+	return syslogWriter{w: w}, nil
+}
+
+type syslogWriter struct {
+	w *syslog.Writer
+}
+
+func (s syslogWriter) Write(p []byte) (int, error) {
+	msg := string(bytes.TrimRight(p, "\n"))
+	var err error
+	switch syslogLevel(p) {
+	case "trace", "debug":
+		err = s.w.Debug(msg)
+	case "warn":
+		err = s.w.Warning(msg)
+	case "error":
+		err = s.w.Err(msg)
+	case "fatal":
+		err = s.w.Crit(msg)
+	case "panic":
+		err = s.w.Emerg(msg)
+	default:
+		err = s.w.Info(msg)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// syslogLevel returns the level field of JSON line p, or "" if absent.
+func syslogLevel(p []byte) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(p, &fields); err != nil {
+		return ""
+	}
+	var level string
+	json.Unmarshal(fields[zerolog.LevelFieldName], &level)
+	return level
+}
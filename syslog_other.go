@@ -0,0 +1,14 @@
+//go:build windows || plan9
+
+package logs
+
+import (
+	"errors"
+	"io"
+)
+
+// SyslogWriter is not supported on Windows and Plan 9, which lack log/syslog.
+// It returns errors.ErrUnsupported.
+func SyslogWriter(network, addr, tag string) (io.Writer, error) {
+	return nil, errors.ErrUnsupported
+}
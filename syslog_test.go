@@ -0,0 +1,46 @@
+//go:build !windows && !plan9
+
+package logs
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestSyslogWriterMapsLevelsToPriorities verifies the syslog priority follows the JSON level field.
+func TestSyslogWriterMapsLevelsToPriorities(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("udp listen unavailable: %v", err)
+	}
+	defer conn.Close()
+
+	w, err := SyslogWriter("udp", conn.LocalAddr().String(), "smplog")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	l := New(w)
+
+	buf := make([]byte, 2048)
+	for _, tc := range []struct {
+		send func()
+		pri  string
+	}{
+		{func() { l.Debug().Msg("d") }, "<15>"},
+		{func() { l.Warn().Msg("w") }, "<12>"},
+		{func() { l.Error().Msg("e") }, "<11>"},
+	} {
+		tc.send()
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("read syslog packet: %v", err)
+		}
+		got := string(buf[:n])
+		if !strings.HasPrefix(got, tc.pri) || !strings.Contains(got, `{"level":`) {
+			t.Fatalf("expected priority %s with JSON payload, got %q", tc.pri, got)
+		}
+	}
+}
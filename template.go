@@ -0,0 +1,53 @@
+package logs
+
+// FieldTemplate is an immutable, reusable set of fields for events that
+// repeat the same structured context:
+//
+//	req := logs.NewFieldTemplate("service", "api", "region", "eu")
+//	req.Apply(logs.Zerolog().Info()).Msg("request served")
+//	reqLog := req.Extend("handler", "login").Logger()
+//
+// The key/value pairs are parsed once, so each Apply only encodes values.
+// For fields on every event of a logger, Logger attaches them once to a
+// child logger's context, which is encoded a single time.
+type FieldTemplate struct {
+	fields []fieldPair
+}
+
+// NewFieldTemplate returns a template from alternating key/value pairs.
+// Non-string keys are formatted with fmt.Sprint; a trailing key without a
+// value is ignored.
+func NewFieldTemplate(keyvals ...any) *FieldTemplate {
+	return &FieldTemplate{fields: fieldPairs(keyvals)}
+}
+
+// Apply adds the template's fields to e in order and returns e for chaining.
+// A nil e (disabled level) is returned unchanged.
+func (t *FieldTemplate) Apply(e *Event) *Event {
+	if e == nil {
+		return nil
+	}
+	for _, f := range t.fields {
+		appendEventField(e, f.key, f.value)
+	}
+	return e
+}
+
+// Extend returns a new template with the given key/value pairs appended.
+// The receiver is unchanged.
+func (t *FieldTemplate) Extend(keyvals ...any) *FieldTemplate {
+	extra := fieldPairs(keyvals)
+	fields := make([]fieldPair, 0, len(t.fields)+len(extra))
+	fields = append(fields, t.fields...)
+	return &FieldTemplate{fields: append(fields, extra...)}
+}
+
+// Logger returns a child of the active logger with the template's fields
+// permanently attached.
+func (t *FieldTemplate) Logger() Logger {
+	c := Zerolog().With()
+	for _, f := range t.fields {
+		c = appendField(c, f.key, f.value)
+	}
+	return c.Logger()
+}
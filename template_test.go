@@ -0,0 +1,63 @@
+package logs
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// TestFieldTemplateApplyAndExtend verifies fields are added per event and Extend copies.
+func TestFieldTemplateApplyAndExtend(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	base := NewFieldTemplate("service", "api", "port", 8080)
+	ext := base.Extend("handler", "login")
+	base.Apply(Zerolog().Info()).Msg("base")
+	ext.Apply(Zerolog().Warn()).Msg("extended")
+	base.Apply(Zerolog().Debug()).Msg("disabled")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	if lines[0]["service"] != "api" || lines[0]["port"] != float64(8080) {
+		t.Fatalf("unexpected base line %v", lines[0])
+	}
+	if _, ok := lines[0]["handler"]; ok {
+		t.Fatalf("expected Extend to leave the base template unchanged: %v", lines[0])
+	}
+	if lines[1]["service"] != "api" || lines[1]["handler"] != "login" {
+		t.Fatalf("unexpected extended line %v", lines[1])
+	}
+}
+
+// TestFieldTemplateLogger verifies the child logger carries the fields on every event.
+func TestFieldTemplateLogger(t *testing.T) {
+	var out bytes.Buffer
+	Configure(Config{Writer: &out, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	l := NewFieldTemplate("service", "api").Logger()
+	l.Info().Msg("one")
+	l.Info().Msg("two")
+
+	lines := decodeLines(t, out.String())
+	if len(lines) != 2 || lines[0]["service"] != "api" || lines[1]["service"] != "api" {
+		t.Fatalf("unexpected lines %v", lines)
+	}
+}
+
+// BenchmarkFieldTemplateApply measures applying a prebuilt template to an event.
+func BenchmarkFieldTemplateApply(b *testing.B) {
+	Configure(Config{Writer: io.Discard, Level: InfoLevel, Bypass: true})
+	b.Cleanup(func() { Configure(DefaultConfig()) })
+
+	tmpl := NewFieldTemplate("service", "api", "region", "eu", "port", 8080)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmpl.Apply(Zerolog().Info()).Msg("tick")
+	}
+}
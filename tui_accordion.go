@@ -0,0 +1,55 @@
+package logs
+
+import "strings"
+
+// MenuEntry is one item in an AccordionSection, rendered as a MenuItem row.
+type MenuEntry struct {
+	Label    string
+	Selected bool
+}
+
+// AccordionSection is one collapsible section rendered by Accordion.
+type AccordionSection struct {
+	Title string
+	// Open renders Items below the title.
+	Open  bool
+	Items []MenuEntry
+}
+
+// AccordionParams describes the sections rendered by Accordion.
+type AccordionParams struct {
+	Sections []AccordionSection
+}
+
+// Accordion writes each section's title with the title color, prefixed by
+// "▶" when closed or "▼" when open, followed for open sections by their
+// items as MenuItem rows numbered from 1. Open/closed state is owned by the
+// caller; Accordion only renders it. Lines are clipped to TUI.MaxWidth.
+func Accordion(p *AccordionParams) (int, error) {
+	if p == nil {
+		return 0, nil
+	}
+	cfg := Configured()
+	var b strings.Builder
+	line := func(color, text string) {
+		if cfg.TUI.MaxWidth > 0 {
+			text = Clip(cfg.TUI.MaxWidth, text)
+		}
+		b.WriteString(colorize(color, text, cfg.NoColor))
+		b.WriteByte('\n')
+	}
+	for _, section := range p.Sections {
+		marker := "▶"
+		if section.Open {
+			marker = "▼"
+		}
+		line(cfg.Colors.title(), marker+" "+section.Title)
+		if !section.Open {
+			continue
+		}
+		for i, item := range section.Items {
+			line(menuItemText(cfg, i+1, item.Label, item.Selected))
+		}
+	}
+	return writeStdout(cfg, b.String())
+}
@@ -0,0 +1,53 @@
+package logs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAccordionRendersOpenSectionsOnly(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		_, err := Accordion(&AccordionParams{Sections: []AccordionSection{
+			{Title: "Services", Open: true, Items: []MenuEntry{{Label: "api"}, {Label: "worker", Selected: true}}},
+			{Title: "Hosts", Items: []MenuEntry{{Label: "hidden"}}},
+		}})
+		if err != nil {
+			t.Fatalf("accordion: %v", err)
+		}
+	})
+
+	want := strings.Join([]string{
+		"▼ Services",
+		"   1) api",
+		">  2) worker",
+		"▶ Hosts",
+	}, "\n") + "\n"
+	if out != want {
+		t.Fatalf("unexpected accordion:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestAccordionColorsAndMaxWidth(t *testing.T) {
+	title, menu := StyleColor256(3), StyleColor256(4)
+	Configure(Config{
+		Colors: ConsoleColors{Title: title, Menu: menu},
+		TUI:    TUIConfig{MaxWidth: 8},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		_, _ = Accordion(&AccordionParams{Sections: []AccordionSection{
+			{Title: "Long section title", Open: true, Items: []MenuEntry{{Label: "entry"}}},
+		}})
+	})
+
+	if !strings.Contains(out, title+"▼ Long s"+StyleReset) {
+		t.Fatalf("expected clipped title in title color: %q", out)
+	}
+	if !strings.Contains(out, menu+"   1) en"+StyleReset) {
+		t.Fatalf("expected clipped item in menu color: %q", out)
+	}
+}
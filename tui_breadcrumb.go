@@ -0,0 +1,98 @@
+package logs
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const defaultBreadcrumbSep = " › "
+
+// BreadcrumbParams describes a navigation path rendered with
+// BreadcrumbWithParams.
+type BreadcrumbParams struct {
+	Crumbs []string
+	// Sep joins crumbs. Defaults to " › ".
+	Sep string
+	// ActiveIndex is the crumb rendered with the title color; out-of-range
+	// values highlight none.
+	ActiveIndex int
+	// Width elides middle crumbs with "…" when the path is wider. Zero uses
+	// TUI.MaxWidth.
+	Width int
+}
+
+// Breadcrumb writes crumbs joined by " › " with the last crumb active,
+// eliding middle crumbs to fit TUI.MaxWidth.
+func Breadcrumb(crumbs []string) (int, error) {
+	return BreadcrumbWithParams(&BreadcrumbParams{Crumbs: crumbs, ActiveIndex: len(crumbs) - 1})
+}
+
+// BreadcrumbWithParams writes p.Crumbs joined by p.Sep on one line, with
+// the active crumb in the title color and the others in the menu color.
+// When the path is wider than the width limit, the first crumb is kept and
+// as many trailing crumbs as fit follow a "…" placeholder:
+//
+//	home › … › disks › sda1
+//
+// It is a no-op when p is nil or has no crumbs.
+func BreadcrumbWithParams(p *BreadcrumbParams) (int, error) {
+	if p == nil || len(p.Crumbs) == 0 {
+		return 0, nil
+	}
+	cfg := Configured()
+	sep := p.Sep
+	if sep == "" {
+		sep = defaultBreadcrumbSep
+	}
+	width := p.Width
+	if width <= 0 {
+		width = cfg.TUI.MaxWidth
+	}
+
+	shown := breadcrumbIndexes(p.Crumbs, utf8.RuneCountInString(sep), width)
+	parts := make([]string, 0, len(shown))
+	for _, i := range shown {
+		switch {
+		case i < 0:
+			parts = append(parts, colorize(cfg.Colors.menu(), "…", cfg.NoColor))
+		case i == p.ActiveIndex:
+			parts = append(parts, colorize(cfg.Colors.title(), p.Crumbs[i], cfg.NoColor))
+		default:
+			parts = append(parts, colorize(cfg.Colors.menu(), p.Crumbs[i], cfg.NoColor))
+		}
+	}
+	return writeStdout(cfg, strings.Join(parts, colorize(cfg.Colors.menu(), sep, cfg.NoColor)))
+}
+
+// breadcrumbIndexes returns the crumb indexes to render, with -1 marking
+// the "…" placeholder. Without a width limit, or when everything fits, all
+// crumbs are shown.
+func breadcrumbIndexes(crumbs []string, sepWidth, width int) []int {
+	all := make([]int, len(crumbs))
+	total := 0
+	for i, c := range crumbs {
+		all[i] = i
+		total += utf8.RuneCountInString(c)
+	}
+	total += sepWidth * (len(crumbs) - 1)
+	if width <= 0 || total <= width || len(crumbs) <= 2 {
+		return all
+	}
+
+	// first › … › tail...
+	used := utf8.RuneCountInString(crumbs[0]) + 2*sepWidth + 1
+	start := len(crumbs) - 1
+	used += utf8.RuneCountInString(crumbs[start])
+	for start > 1 {
+		next := used + utf8.RuneCountInString(crumbs[start-1]) + sepWidth
+		if next > width {
+			break
+		}
+		used = next
+		start--
+	}
+	if start == 1 {
+		return all
+	}
+	return append([]int{0, -1}, all[start:]...)
+}
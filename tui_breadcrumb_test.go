@@ -0,0 +1,67 @@
+package logs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBreadcrumbHighlightsLastCrumb(t *testing.T) {
+	menu, title := StyleColor256(4), StyleColor256(5)
+	Configure(Config{Colors: ConsoleColors{Menu: menu, Title: title}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		if _, err := Breadcrumb([]string{"home", "disks"}); err != nil {
+			t.Fatalf("breadcrumb: %v", err)
+		}
+	})
+
+	want := menu + "home" + StyleReset + menu + " › " + StyleReset + title + "disks" + StyleReset
+	if out != want {
+		t.Fatalf("unexpected breadcrumb:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestBreadcrumbWithParamsSepAndActive(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		_, _ = BreadcrumbWithParams(&BreadcrumbParams{Crumbs: []string{"a", "b", "c"}, Sep: "/", ActiveIndex: 1})
+	})
+	if out != "a/b/c" {
+		t.Fatalf("unexpected breadcrumb %q", out)
+	}
+}
+
+func TestBreadcrumbElidesMiddleCrumbs(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	crumbs := []string{"home", "storage", "volumes", "disks", "sda1"}
+	cases := map[int]string{
+		0:  "home › storage › volumes › disks › sda1",
+		40: "home › storage › volumes › disks › sda1",
+		33: "home › … › volumes › disks › sda1",
+		32: "home › … › disks › sda1",
+		24: "home › … › disks › sda1",
+		5:  "home › … › sda1",
+	}
+	for width, want := range cases {
+		out := captureStdout(t, func() {
+			_, _ = BreadcrumbWithParams(&BreadcrumbParams{Crumbs: crumbs, ActiveIndex: 4, Width: width})
+		})
+		if out != want {
+			t.Errorf("width %d: got %q, want %q", width, out, want)
+		}
+		if width >= 24 && len([]rune(out)) > width {
+			t.Errorf("width %d: %q exceeds limit", width, out)
+		}
+	}
+
+	Configure(Config{NoColor: true, TUI: TUIConfig{MaxWidth: 24}})
+	out := captureStdout(t, func() { _, _ = Breadcrumb(crumbs) })
+	if !strings.Contains(out, "…") {
+		t.Fatalf("expected MaxWidth to elide crumbs, got %q", out)
+	}
+}
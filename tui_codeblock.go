@@ -0,0 +1,225 @@
+package logs
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CodeBlockParams describes a code snippet rendered with
+// CodeBlockWithParams.
+type CodeBlockParams struct {
+	Code string
+	// Language selects the highlighter: "go", "json" or "toml". Other
+	// values render the code as plain text in the data color.
+	Language string
+	// Width clips each line to this many runes. Zero uses TUI.MaxWidth.
+	Width int
+	// Theme "plain" disables highlighting; any other value (including "")
+	// uses the configured palette.
+	Theme string
+}
+
+// CodeBlock writes code highlighted for lang, one line per source line.
+func CodeBlock(code, lang string) (int, error) {
+	return CodeBlockWithParams(&CodeBlockParams{Code: code, Language: lang})
+}
+
+// CodeBlockWithParams writes p.Code with a minimal line-based highlighter.
+// Keywords use the menu color, strings the prompt color, comments the
+// divider color, JSON/TOML keys the field-name color and everything else
+// the data color. Tokens do not span lines, so block comments and raw
+// strings are only recognized within one line. It is a no-op when p is nil.
+func CodeBlockWithParams(p *CodeBlockParams) (int, error) {
+	if p == nil {
+		return 0, nil
+	}
+	cfg := Configured()
+	width := p.Width
+	if width <= 0 {
+		width = cfg.TUI.MaxWidth
+	}
+	lang, ok := codeLanguages[strings.ToLower(p.Language)]
+	if !ok || strings.EqualFold(p.Theme, "plain") {
+		lang = nil
+	}
+	colors := map[codeClass]string{
+		codePlain:   cfg.Colors.data(),
+		codeKeyword: cfg.Colors.menu(),
+		codeString:  cfg.Colors.prompt(),
+		codeComment: cfg.Colors.divider(),
+		codeKey:     firstNonEmpty(cfg.Colors.FieldName, cfg.Colors.Info),
+	}
+
+	var b strings.Builder
+	for line := range strings.Lines(strings.TrimSuffix(p.Code, "\n")) {
+		line = strings.TrimRight(line, "\r\n")
+		left := width
+		for _, tok := range highlightCode(lang, line) {
+			if width > 0 {
+				if left <= 0 {
+					break
+				}
+				tok.text = Clip(left, tok.text)
+				left -= utf8.RuneCountInString(tok.text)
+			}
+			b.WriteString(colorize(colors[tok.class], tok.text, cfg.NoColor))
+		}
+		b.WriteByte('\n')
+	}
+	return writeStdout(cfg, b.String())
+}
+
+type codeClass int
+
+const (
+	codePlain codeClass = iota
+	codeKeyword
+	codeString
+	codeComment
+	codeKey
+)
+
+type codeToken struct {
+	class codeClass
+	text  string
+}
+
+// codeLanguage describes the lexical rules highlightCode applies.
+type codeLanguage struct {
+	comment string
+	block   bool // /* */ comments
+	quotes  string
+	// rawQuotes are the quotes whose literals have no backslash escapes.
+	rawQuotes string
+	keywords  map[string]bool
+	// jsonKeys marks strings followed by ':' as keys; tomlKeys marks a bare
+	// or quoted word before '=' at the start of a line as a key and
+	// [table] headers as keywords.
+	jsonKeys, tomlKeys bool
+}
+
+var codeLanguages = map[string]*codeLanguage{
+	"go": {
+		comment:   "//",
+		block:     true,
+		quotes:    "\"'`",
+		rawQuotes: "`",
+		keywords: wordSet("break case chan const continue default defer else fallthrough for func go goto if " +
+			"import interface map package range return select struct switch type var " +
+			"true false nil iota"),
+	},
+	"json": {
+		quotes:   `"`,
+		keywords: wordSet("true false null"),
+		jsonKeys: true,
+	},
+	"toml": {
+		comment:   "#",
+		quotes:    `"'`,
+		rawQuotes: "'",
+		keywords:  wordSet("true false inf nan"),
+		tomlKeys:  true,
+	},
+}
+
+func wordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+// highlightCode splits one source line into classified tokens. A nil lang
+// yields the whole line as plain text.
+func highlightCode(lang *codeLanguage, line string) []codeToken {
+	if lang == nil || line == "" {
+		return []codeToken{{codePlain, line}}
+	}
+	if lang.tomlKeys && strings.HasPrefix(strings.TrimSpace(line), "[") {
+		return []codeToken{{codeKeyword, line}}
+	}
+	var toks []codeToken
+	emit := func(class codeClass, text string) {
+		if n := len(toks); n > 0 && toks[n-1].class == class {
+			toks[n-1].text += text
+			return
+		}
+		toks = append(toks, codeToken{class, text})
+	}
+	atKey := lang.tomlKeys
+	for i := 0; i < len(line); {
+		rest := line[i:]
+		switch c := line[i]; {
+		case lang.comment != "" && strings.HasPrefix(rest, lang.comment):
+			emit(codeComment, rest)
+			return toks
+		case lang.block && strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				emit(codeComment, rest)
+				return toks
+			}
+			emit(codeComment, rest[:end+4])
+			i += end + 4
+		case strings.IndexByte(lang.quotes, c) >= 0:
+			n := quotedLen(rest, strings.IndexByte(lang.rawQuotes, c) >= 0)
+			class := codeString
+			if lang.jsonKeys && followedBy(rest[n:], ':') || atKey && followedBy(rest[n:], '=') {
+				class = codeKey
+			}
+			emit(class, rest[:n])
+			i += n
+		case isCodeWordByte(c):
+			n := 1
+			for n < len(rest) && (isCodeWordByte(rest[n]) || (lang.tomlKeys && (rest[n] == '-' || rest[n] == '.'))) {
+				n++
+			}
+			word := rest[:n]
+			class := codePlain
+			switch {
+			case atKey && followedBy(rest[n:], '='):
+				class = codeKey
+			case lang.keywords[word]:
+				class = codeKeyword
+			}
+			emit(class, word)
+			i += n
+		default:
+			if c == '=' {
+				atKey = false
+			}
+			_, n := utf8.DecodeRuneInString(rest)
+			emit(codePlain, rest[:n])
+			i += n
+		}
+	}
+	return toks
+}
+
+// followedBy reports whether the first non-blank byte of s is c.
+func followedBy(s string, c byte) bool {
+	s = strings.TrimLeft(s, " \t")
+	return s != "" && s[0] == c
+}
+
+// quotedLen returns the byte length of the quoted literal at the start of
+// s, including both quotes. Backslash escapes apply unless raw; an
+// unterminated literal runs to the end of s.
+func quotedLen(s string, raw bool) int {
+	q := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && !raw:
+			i++
+		case s[i] == q:
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+func isCodeWordByte(c byte) bool {
+	return c == '_' || c >= utf8.RuneSelf || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
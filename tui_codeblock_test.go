@@ -0,0 +1,101 @@
+package logs
+
+import (
+	"strings"
+	"testing"
+)
+
+func codeTestColors() ConsoleColors {
+	return ConsoleColors{
+		Menu:      StyleColor256(1),
+		Prompt:    StyleColor256(2),
+		Divider:   StyleColor256(3),
+		FieldName: StyleColor256(4),
+		Data:      StyleColor256(5),
+	}
+}
+
+func TestCodeBlockGoKeywordsDifferFromStrings(t *testing.T) {
+	colors := codeTestColors()
+	Configure(Config{Colors: colors})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		if _, err := CodeBlock("func main() {\n\tprintln(\"hi\") // greet\n}\n", "go"); err != nil {
+			t.Fatalf("codeblock: %v", err)
+		}
+	})
+
+	keyword := colors.Menu + "func" + StyleReset
+	str := colors.Prompt + `"hi"` + StyleReset
+	comment := colors.Divider + "// greet" + StyleReset
+	for _, want := range []string{keyword, str, comment, colors.Data + " main() {" + StyleReset} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in %q", want, out)
+		}
+	}
+	if colors.Menu == colors.Prompt {
+		t.Fatal("test palette must use distinct keyword and string colors")
+	}
+	if got := strings.Count(out, "\n"); got != 3 {
+		t.Fatalf("expected 3 lines, got %d in %q", got, out)
+	}
+}
+
+func TestCodeBlockJSONAndTOMLKeys(t *testing.T) {
+	colors := codeTestColors()
+	Configure(Config{Colors: colors})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		_, _ = CodeBlock(`{"level": "info", "bypass": true}`, "json")
+	})
+	for _, want := range []string{
+		colors.FieldName + `"level"` + StyleReset,
+		colors.Prompt + `"info"` + StyleReset,
+		colors.Menu + "true" + StyleReset,
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in json output %q", want, out)
+		}
+	}
+
+	out = captureStdout(t, func() {
+		_, _ = CodeBlock("[[tui]]\nmax_width = 0 # unlimited\npath = 'C:\\logs\\'", "TOML")
+	})
+	for _, want := range []string{
+		colors.Menu + "[[tui]]" + StyleReset,
+		colors.FieldName + "max_width" + StyleReset,
+		colors.Divider + "# unlimited" + StyleReset,
+		colors.Prompt + `'C:\logs\'` + StyleReset,
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in toml output %q", want, out)
+		}
+	}
+}
+
+func TestCodeBlockPlainFallbacks(t *testing.T) {
+	colors := codeTestColors()
+	Configure(Config{Colors: colors})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() { _, _ = CodeBlock("if x then y", "lua") })
+	if out != colors.Data+"if x then y"+StyleReset+"\n" {
+		t.Fatalf("expected unsupported language in data color, got %q", out)
+	}
+	out = captureStdout(t, func() {
+		_, _ = CodeBlockWithParams(&CodeBlockParams{Code: "func f()", Language: "go", Theme: "plain"})
+	})
+	if out != colors.Data+"func f()"+StyleReset+"\n" {
+		t.Fatalf("expected plain theme in data color, got %q", out)
+	}
+
+	Configure(Config{NoColor: true})
+	out = captureStdout(t, func() {
+		_, _ = CodeBlockWithParams(&CodeBlockParams{Code: "return \"long string\"", Language: "go", Width: 10})
+	})
+	if out != "return \"lo\n" {
+		t.Fatalf("expected uncolored clipped line, got %q", out)
+	}
+}
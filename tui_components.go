@@ -0,0 +1,107 @@
+package logs
+
+// Component is a renderable TUI element.
+// Render receives the config snapshot taken by the caller so a full layout
+// renders with one consistent palette and TUI settings.
+type Component interface {
+	Render(cfg Config) error
+}
+
+// ComponentFunc adapts a plain function to the Component interface.
+type ComponentFunc func(cfg Config) error
+
+// Render calls f(cfg).
+func (f ComponentFunc) Render(cfg Config) error {
+	return f(cfg)
+}
+
+// Render renders components in order against the active config,
+// stopping at the first error.
+func Render(components []Component) error {
+	return renderAll(Configured(), components)
+}
+
+func renderAll(cfg Config, components []Component) error {
+	for _, c := range components {
+		if c == nil {
+			continue
+		}
+		if err := c.Render(cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VStack returns a Component that renders components top-to-bottom,
+// writing a newline after each one.
+func VStack(components ...Component) Component {
+	return ComponentFunc(func(cfg Config) error {
+		for _, c := range components {
+			if c == nil {
+				continue
+			}
+			if err := c.Render(cfg); err != nil {
+				return err
+			}
+			if _, err := writeStdout(cfg, "\n"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Conditional returns a Component that renders c only when pred returns true.
+func Conditional(pred func() bool, c Component) Component {
+	return ComponentFunc(func(cfg Config) error {
+		if pred == nil || !pred() || c == nil {
+			return nil
+		}
+		return c.Render(cfg)
+	})
+}
+
+// TitleComponent renders Text with the title color.
+type TitleComponent struct {
+	Text string
+}
+
+// Render implements Component.
+func (c TitleComponent) Render(cfg Config) error {
+	_, err := writeColored(cfg, cfg.Colors.title(), c.Text)
+	return err
+}
+
+// MenuComponent renders Items as MenuItem rows, one per line.
+// Selected is the 0-based index of the highlighted item; use -1 for none.
+type MenuComponent struct {
+	Items    []string
+	Selected int
+}
+
+// Render implements Component.
+func (c MenuComponent) Render(cfg Config) error {
+	for i, item := range c.Items {
+		if _, err := menuItem(cfg, i+1, item, i == c.Selected); err != nil {
+			return err
+		}
+		if _, err := writeStdout(cfg, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DividerComponent renders a horizontal divider with the divider color.
+// Width <= 0 uses TUIConfig.DividerWidth; Rune 0 uses '-'.
+type DividerComponent struct {
+	Width int
+	Rune  rune
+}
+
+// Render implements Component.
+func (c DividerComponent) Render(cfg Config) error {
+	_, err := divider(cfg, c.Width, c.Rune)
+	return err
+}
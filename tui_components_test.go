@@ -0,0 +1,59 @@
+package logs
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRenderVStackComponents(t *testing.T) {
+	Configure(Config{
+		NoColor: true,
+		TUI: TUIConfig{
+			MenuSelectedPrefix:   ">",
+			MenuUnselectedPrefix: " ",
+			MenuIndexWidth:       1,
+			DividerWidth:         5,
+		},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		err := Render([]Component{
+			VStack(
+				TitleComponent{Text: "Main"},
+				DividerComponent{},
+			),
+			Conditional(func() bool { return false }, TitleComponent{Text: "hidden"}),
+			MenuComponent{Items: []string{"status", "quit"}, Selected: 1},
+		})
+		if err != nil {
+			t.Fatalf("render: %v", err)
+		}
+	})
+
+	want := "Main\n-----\n  1) status\n> 2) quit\n"
+	if out != want {
+		t.Fatalf("unexpected render output:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestRenderStopsOnFirstError(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	count := ComponentFunc(func(Config) error { calls++; return nil })
+	fail := ComponentFunc(func(Config) error { return boom })
+
+	out := captureStdout(t, func() {
+		if err := Render([]Component{count, fail, count}); !errors.Is(err, boom) {
+			t.Fatalf("render: got %v, want %v", err, boom)
+		}
+	})
+
+	if calls != 1 {
+		t.Fatalf("expected rendering to stop after error, got %d calls", calls)
+	}
+	if strings.TrimSpace(out) != "" {
+		t.Fatalf("expected no output, got %q", out)
+	}
+}
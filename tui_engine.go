@@ -2,7 +2,7 @@ package logs
 
 import (
 	"fmt"
-	"os"
+	"io"
 	"strings"
 	"unicode/utf8"
 )
@@ -13,6 +13,7 @@ const (
 	defaultMenuIndexWidth       = 2
 	defaultInputCursor          = "_"
 	defaultDividerWidth         = 64
+	defaultIndentUnit           = "  "
 )
 
 // TUIConfig controls compact menu/TUI output helpers.
@@ -22,6 +23,15 @@ type TUIConfig struct {
 	MenuIndexWidth       int
 	InputCursor          string
 	DividerWidth         int
+	// MaxWidth caps the width of rendered components. Zero means unlimited.
+	// WatchTerminalSize keeps it in sync with the terminal width.
+	MaxWidth int
+	// IndentUnit is one level of Indent/IndentBlock indentation. Defaults to
+	// two spaces.
+	IndentUnit string
+	// LeftMargin is the number of columns of spaces written before each line
+	// of stdout helper output. IndentBlock adjusts it temporarily.
+	LeftMargin int
 }
 
 // DefaultTUIConfig returns defaults used by printf/tui_engine helpers.
@@ -32,6 +42,7 @@ func DefaultTUIConfig() TUIConfig {
 		MenuIndexWidth:       defaultMenuIndexWidth,
 		InputCursor:          defaultInputCursor,
 		DividerWidth:         defaultDividerWidth,
+		IndentUnit:           defaultIndentUnit,
 	}
 }
 
@@ -52,6 +63,15 @@ func normalizeTUIConfig(cfg TUIConfig) TUIConfig {
 	if cfg.DividerWidth <= 0 {
 		cfg.DividerWidth = def.DividerWidth
 	}
+	if cfg.MaxWidth < 0 {
+		cfg.MaxWidth = 0
+	}
+	if cfg.IndentUnit == "" {
+		cfg.IndentUnit = def.IndentUnit
+	}
+	if cfg.LeftMargin < 0 {
+		cfg.LeftMargin = 0
+	}
 	return cfg
 }
 
@@ -80,9 +100,80 @@ func MoveTo(row, col int) (int, error) {
 	return writeANSI(fmt.Sprintf("\033[%d;%dH", maxOne(row), maxOne(col)))
 }
 
+// MoveToHome moves the cursor to the top-left corner (1,1).
+func MoveToHome() (int, error) {
+	return writeANSI("\033[H")
+}
+
+// MoveToEnd moves the cursor to the bottom-right corner of the viewport.
+// Terminals clamp the oversized position to the last row/column.
+func MoveToEnd() (int, error) {
+	return writeANSI("\033[999;999H")
+}
+
+// MoveUp moves the cursor up n rows (minimum 1).
+func MoveUp(n int) (int, error) {
+	return writeANSI(fmt.Sprintf("\033[%dA", maxOne(n)))
+}
+
+// MoveDown moves the cursor down n rows (minimum 1).
+func MoveDown(n int) (int, error) {
+	return writeANSI(fmt.Sprintf("\033[%dB", maxOne(n)))
+}
+
+// MoveRight moves the cursor right n columns (minimum 1).
+func MoveRight(n int) (int, error) {
+	return writeANSI(fmt.Sprintf("\033[%dC", maxOne(n)))
+}
+
+// MoveLeft moves the cursor left n columns (minimum 1).
+func MoveLeft(n int) (int, error) {
+	return writeANSI(fmt.Sprintf("\033[%dD", maxOne(n)))
+}
+
+// SaveCursor stores the current cursor position.
+func SaveCursor() (int, error) {
+	return writeANSI("\0337")
+}
+
+// RestoreCursor returns the cursor to the position stored by SaveCursor.
+func RestoreCursor() (int, error) {
+	return writeANSI("\0338")
+}
+
+// Refresh clears the full terminal viewport and moves the cursor to 1,1.
+func Refresh() error {
+	if _, err := ClearScreen(); err != nil {
+		return err
+	}
+	_, err := MoveTo(1, 1)
+	return err
+}
+
 // ClearScreen clears the full terminal viewport.
+// It is equivalent to EraseDisplay(2).
 func ClearScreen() (int, error) {
-	return writeANSI("\033[2J")
+	return EraseDisplay(2)
+}
+
+// EraseDisplay erases part of the viewport:
+// 0 clears from the cursor to the end, 1 from the beginning to the cursor,
+// and 2 the whole screen. Other modes return an error without writing.
+func EraseDisplay(mode int) (int, error) {
+	if mode < 0 || mode > 2 {
+		return 0, fmt.Errorf("smplog: invalid erase display mode %d", mode)
+	}
+	return writeANSI(fmt.Sprintf("\033[%dJ", mode))
+}
+
+// ClearToEndOfLine clears from the cursor to the end of the current line.
+func ClearToEndOfLine() (int, error) {
+	return writeANSI("\033[0K")
+}
+
+// ClearToStartOfLine clears from the start of the current line to the cursor.
+func ClearToStartOfLine() (int, error) {
+	return writeANSI("\033[1K")
 }
 
 // ClearLine clears the current line and returns the cursor to column 1.
@@ -99,10 +190,48 @@ func WriteAt(row, col int, color, format string, v ...any) (int, error) {
 	}
 	text := fmt.Sprintf(format, v...)
 	cfg := Configured()
-	m, err := fmt.Fprint(os.Stdout, colorize(color, text, cfg.NoColor))
+	m, err := fmt.Fprint(tuiOutput(cfg), colorize(color, text, cfg.NoColor))
 	return n + m, err
 }
 
+// WriteAtStyled is WriteAt with several styles merged via Compose,
+// e.g. []string{StyleBold, StyleColor256(10)}.
+func WriteAtStyled(row, col int, styles []string, format string, v ...any) (int, error) {
+	return WriteAt(row, col, Compose(styles...), format, v...)
+}
+
+// WriteAtNoColor moves to row/col and writes a formatted message without any
+// color, regardless of Config.NoColor.
+func WriteAtNoColor(row, col int, format string, v ...any) (int, error) {
+	return WriteAt(row, col, "", format, v...)
+}
+
+// WriteOp is a single positioned write for BatchWrite.
+type WriteOp struct {
+	Row, Col int
+	Color    string
+	Text     string
+}
+
+// BatchWrite renders all ops to the TUI output (stdout by default) in a
+// single write to avoid flicker from partially drawn frames. Color output is
+// controlled by Config.NoColor.
+func BatchWrite(ops []WriteOp) error {
+	return BatchWriteToWriter(tuiOutput(Configured()), ops)
+}
+
+// BatchWriteToWriter renders all ops to w in a single write.
+func BatchWriteToWriter(w io.Writer, ops []WriteOp) error {
+	cfg := Configured()
+	var b strings.Builder
+	for _, op := range ops {
+		fmt.Fprintf(&b, "\033[%d;%dH", maxOne(op.Row), maxOne(op.Col))
+		b.WriteString(colorize(op.Color, op.Text, cfg.NoColor))
+	}
+	_, err := fmt.Fprint(w, b.String())
+	return err
+}
+
 // Clip truncates s to width runes.
 func Clip(width int, s string) string {
 	if width <= 0 {
@@ -200,32 +329,118 @@ func Divider(width int) (int, error) {
 }
 
 // DividerRune writes a horizontal divider using r and Config.Colors.Divider.
-// If width <= 0, a default width is used.
+// If width <= 0, a default width is used. The divider is framed by a blank
+// line and three spaces of padding on each side; width covers the whole
+// frame, so the rule itself is dividerFrame runes shorter.
 func DividerRune(width int, r rune) (int, error) {
+	cfg := Configured()
+	rule := max(dividerWidth(cfg, width)-dividerFrame, 1)
+	line := "   " + strings.Repeat(string(dividerRune(r)), rule) + "   "
+	return writeColored(cfg, cfg.Colors.divider(), "\n"+line+"\n")
+}
+
+// divider writes a bare divider of exactly width runes, without the blank
+// line and padding DividerRune adds, for DividerComponent.
+func divider(cfg Config, width int, r rune) (int, error) {
+	return writeColored(cfg, cfg.Colors.divider(), dividerLine(cfg, width, r))
+}
+
+// dividerFrame is the width of DividerRune's frame: the leading and trailing
+// newline and three spaces of padding on each side of the rule.
+const dividerFrame = len("\n   " + "   \n")
+
+// dividerLine returns dividerWidth(cfg, width) repetitions of r.
+func dividerLine(cfg Config, width int, r rune) string {
+	return strings.Repeat(string(dividerRune(r)), dividerWidth(cfg, width))
+}
+
+// dividerWidth resolves width <= 0 to TUIConfig.DividerWidth and caps it at
+// TUIConfig.MaxWidth.
+func dividerWidth(cfg Config, width int) int {
 	if width <= 0 {
-		width = Configured().TUI.DividerWidth
+		width = cfg.TUI.DividerWidth
 	}
 	if width <= 0 {
 		width = defaultDividerWidth
 	}
+	if cfg.TUI.MaxWidth > 0 {
+		width = min(width, cfg.TUI.MaxWidth)
+	}
+	return width
+}
+
+// dividerRune returns r, or '-' when r is 0.
+func dividerRune(r rune) rune {
 	if r == 0 {
-		r = '-'
+		return '-'
+	}
+	return r
+}
+
+// Repeat calls fn n times. It is a no-op when n <= 0.
+func Repeat(n int, fn func()) {
+	for i := 0; i < n; i++ {
+		fn()
 	}
-	line := "   " + strings.Repeat(string(r), width) + "   "
-	return printfColorf(Configured().Colors.divider(), "\n%s\n", line)
+}
+
+// Indent returns n repetitions of Config.TUI.IndentUnit (two spaces by
+// default), or "" when n <= 0.
+func Indent(n int) string {
+	return strings.Repeat(Configured().TUI.IndentUnit, max(n, 0))
+}
+
+// IndentBlock calls fn with TUI.LeftMargin raised by n indent units, so
+// every line the print and TUI helpers write inside fn is indented. The
+// previous margin is restored when fn returns, and blocks nest:
+//
+//	logs.Title("Settings\n")
+//	logs.IndentBlock(1, func() {
+//	    logs.Field("theme", "dark")
+//	    logs.Println("")
+//	})
+func IndentBlock(n int, fn func()) {
+	tui := Configured().TUI
+	setTUILeftMargin(tui.LeftMargin + max(n, 0)*utf8.RuneCountInString(tui.IndentUnit))
+	defer setTUILeftMargin(tui.LeftMargin)
+	fn()
+}
+
+// RepeatStr writes s n times using Config.Colors.Divider.
+func RepeatStr(n int, s string) (int, error) {
+	return printfColorf(Configured().Colors.divider(), "%s", strings.Repeat(s, max(n, 0)))
+}
+
+// RepeatRune writes r n times using Config.Colors.Divider.
+func RepeatRune(n int, r rune) (int, error) {
+	return RepeatStr(n, string(r))
+}
+
+// VPad writes n blank lines for vertical spacing.
+func VPad(n int) (int, error) {
+	return writeStdout(Configured(), strings.Repeat("\n", max(n, 0)))
 }
 
 // MenuItem writes a compact menu entry.
 // Selected entries are rendered with title color; others use menu color.
 func MenuItem(index int, label string, selected bool) (int, error) {
-	cfg := Configured()
+	return menuItem(Configured(), index, label, selected)
+}
+
+func menuItem(cfg Config, index int, label string, selected bool) (int, error) {
+	color, text := menuItemText(cfg, index, label, selected)
+	return writeColored(cfg, color, text)
+}
+
+// menuItemText returns the color and uncolored text of a menu entry.
+func menuItemText(cfg Config, index int, label string, selected bool) (string, string) {
 	color := cfg.Colors.menu()
 	prefix := cfg.TUI.MenuUnselectedPrefix
 	if selected {
 		color = cfg.Colors.title()
 		prefix = cfg.TUI.MenuSelectedPrefix
 	}
-	return printfColorf(color, "%s %*d) %s", prefix, cfg.TUI.MenuIndexWidth, index, label)
+	return color, fmt.Sprintf("%s %*d) %s", prefix, cfg.TUI.MenuIndexWidth, index, label)
 }
 
 // KeyHint writes a keyboard hint using prompt and data colors.
@@ -233,7 +448,7 @@ func KeyHint(key, desc string) (int, error) {
 	cfg := Configured()
 	keyText := colorize(cfg.Colors.prompt(), key, cfg.NoColor)
 	descText := colorize(cfg.Colors.data(), desc, cfg.NoColor)
-	return fmt.Fprintf(os.Stdout, "[%s] %s", keyText, descText)
+	return writeStdout(cfg, fmt.Sprintf("[%s] %s", keyText, descText))
 }
 
 // Field writes a key/value pair using prompt and data colors.
@@ -241,7 +456,49 @@ func Field(label string, value any) (int, error) {
 	cfg := Configured()
 	labelText := colorize(cfg.Colors.prompt(), label, cfg.NoColor)
 	valueText := colorize(cfg.Colors.data(), fmt.Sprint(value), cfg.NoColor)
-	return fmt.Fprintf(os.Stdout, "%s: %s", labelText, valueText)
+	return writeStdout(cfg, fmt.Sprintf("%s: %s", labelText, valueText))
+}
+
+// BadgeItem is one tag rendered by BadgeLine.
+type BadgeItem struct {
+	Text string
+	// Color styles the badge; empty falls back to the prompt color.
+	Color string
+}
+
+// Badge writes "[text]" inline, with brackets and text in color (or the
+// prompt color when color is empty).
+func Badge(text, color string) (int, error) {
+	cfg := Configured()
+	return writeStdout(cfg, badge(cfg, text, color))
+}
+
+func badge(cfg Config, text, color string) string {
+	if color == "" {
+		color = cfg.Colors.prompt()
+	}
+	return colorize(color, "["+text+"]", cfg.NoColor)
+}
+
+// BadgeLine writes "label: [a] [b] ..." on one line with the label in the
+// prompt color and each badge in its own color. When TUI.MaxWidth is set,
+// the label is clipped and badges that would not fit whole are dropped.
+func BadgeLine(label string, badges []BadgeItem) (int, error) {
+	cfg := Configured()
+	text := label + ":"
+	if cfg.TUI.MaxWidth > 0 {
+		text = Clip(cfg.TUI.MaxWidth, text)
+	}
+	width := utf8.RuneCountInString(text)
+	line := colorize(cfg.Colors.prompt(), text, cfg.NoColor)
+	for _, b := range badges {
+		width += utf8.RuneCountInString(b.Text) + 3
+		if cfg.TUI.MaxWidth > 0 && width > cfg.TUI.MaxWidth {
+			break
+		}
+		line += " " + badge(cfg, b.Text, b.Color)
+	}
+	return writeStdout(cfg, line)
 }
 
 // StatusInfo writes an info-status message.
@@ -259,6 +516,56 @@ func StatusError(msg string) (int, error) {
 	return printfColorf(Configured().Colors.level("error"), "%s", msg)
 }
 
+// NotificationParams describes a one-line flash message for Notification.
+type NotificationParams struct {
+	Message string
+	// Level picks the color: "info", "warn" or "error". Other values use
+	// the info color.
+	Level string
+	// Row is the 1-based screen row the message is written to.
+	Row int
+}
+
+// Notification clears p.Row and writes p.Message there with WriteAt,
+// clipped to TUI.MaxWidth. The cursor position is saved and restored so
+// the rest of the screen is undisturbed. It is a no-op when p is nil.
+func Notification(p *NotificationParams) (int, error) {
+	if p == nil {
+		return 0, nil
+	}
+	cfg := Configured()
+	msg := p.Message
+	if cfg.TUI.MaxWidth > 0 {
+		msg = Clip(cfg.TUI.MaxWidth, msg)
+	}
+	color := firstNonEmpty(cfg.Colors.level(p.Level), cfg.Colors.Info)
+
+	total := 0
+	for _, step := range []func() (int, error){
+		SaveCursor,
+		func() (int, error) { return ClearNotification(p.Row) },
+		func() (int, error) { return WriteAt(p.Row, 1, color, "%s", msg) },
+		RestoreCursor,
+	} {
+		n, err := step()
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// ClearNotification moves the cursor to row and clears that line.
+func ClearNotification(row int) (int, error) {
+	n, err := MoveTo(row, 1)
+	if err != nil {
+		return n, err
+	}
+	m, err := ClearLine()
+	return n + m, err
+}
+
 // InputLine writes a compact prompt/value input row.
 // If active, a lightweight cursor marker is appended.
 func InputLine(prefix, value string, active bool) (int, error) {
@@ -266,10 +573,10 @@ func InputLine(prefix, value string, active bool) (int, error) {
 	prefixText := colorize(cfg.Colors.prompt(), prefix, cfg.NoColor)
 	valueText := colorize(cfg.Colors.data(), value, cfg.NoColor)
 	if !active {
-		return fmt.Fprintf(os.Stdout, "%s%s", prefixText, valueText)
+		return writeStdout(cfg, prefixText+valueText)
 	}
 	cursor := colorize(cfg.Colors.prompt(), cfg.TUI.InputCursor, cfg.NoColor)
-	return fmt.Fprintf(os.Stdout, "%s%s%s", prefixText, valueText, cursor)
+	return writeStdout(cfg, prefixText+valueText+cursor)
 }
 
 // BeginFrame switches to alt-screen, hides the cursor, clears the frame,
@@ -298,7 +605,7 @@ func EndFrame() error {
 }
 
 func writeANSI(seq string) (int, error) {
-	return fmt.Fprint(os.Stdout, seq)
+	return fmt.Fprint(tuiOutput(Configured()), seq)
 }
 
 func maxOne(n int) int {
@@ -1,6 +1,7 @@
 package logs
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 )
@@ -54,6 +55,45 @@ func TestWriteAtRespectsNoColor(t *testing.T) {
 	}
 }
 
+func TestWriteAtStyledAndNoColor(t *testing.T) {
+	Configure(Config{NoColor: false})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		if _, err := WriteAtStyled(2, 4, []string{StyleBold, StyleColor256(10)}, "n=%d", 3); err != nil {
+			t.Fatalf("writeatstyled: %v", err)
+		}
+		if _, err := WriteAtNoColor(5, 1, "plain %s", "text"); err != nil {
+			t.Fatalf("writeatnocolor: %v", err)
+		}
+	})
+
+	want := "\x1b[2;4H\x1b[1m\x1b[38;5;10mn=3\x1b[0m\x1b[5;1Hplain text"
+	if out != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestBatchWriteToWriterKeepsOrder(t *testing.T) {
+	Configure(Config{NoColor: false})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var out bytes.Buffer
+	err := BatchWriteToWriter(&out, []WriteOp{
+		{Row: 1, Col: 1, Color: StyleColor256(15), Text: "title"},
+		{Row: 3, Col: 2, Text: "body"},
+		{Row: 0, Col: 0, Text: "home"},
+	})
+	if err != nil {
+		t.Fatalf("batchwrite: %v", err)
+	}
+
+	want := "\x1b[1;1H\x1b[38;5;15mtitle\x1b[0m\x1b[3;2Hbody\x1b[1;1Hhome"
+	if out.String() != want {
+		t.Fatalf("unexpected batch output:\n got %q\nwant %q", out.String(), want)
+	}
+}
+
 func TestClipPadCenter(t *testing.T) {
 	if got := Clip(4, "abcdef"); got != "abcd" {
 		t.Fatalf("clip: got %q want %q", got, "abcd")
@@ -163,3 +203,175 @@ func TestKeyHintFieldAndInputLineNoColor(t *testing.T) {
 		t.Fatalf("expected active input payload in output: %q", out)
 	}
 }
+
+func TestRepeatHelpers(t *testing.T) {
+	Configure(Config{
+		NoColor: false,
+		Colors: ConsoleColors{
+			Divider: StyleColor256(8),
+		},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	calls := 0
+	Repeat(3, func() { calls++ })
+	if calls != 3 {
+		t.Fatalf("repeat: got %d calls, want 3", calls)
+	}
+
+	out := captureStdout(t, func() {
+		if _, err := RepeatStr(3, "=-"); err != nil {
+			t.Fatalf("repeatstr: %v", err)
+		}
+		if _, err := RepeatRune(4, '#'); err != nil {
+			t.Fatalf("repeatrune: %v", err)
+		}
+		if _, err := VPad(2); err != nil {
+			t.Fatalf("vpad: %v", err)
+		}
+	})
+
+	if !strings.HasPrefix(out, "\x1b[38;5;8m") {
+		t.Fatalf("expected divider ANSI color in output: %q", out)
+	}
+	if got := StripANSI(out); got != "=-=-=-####\n\n" {
+		t.Fatalf("unexpected repeat output: %q", got)
+	}
+}
+
+func TestRelativeCursorMovement(t *testing.T) {
+	out := captureStdout(t, func() {
+		_, _ = MoveToHome()
+		_, _ = MoveToEnd()
+		_, _ = MoveUp(2)
+		_, _ = MoveDown(0)
+		_, _ = MoveRight(5)
+		_, _ = MoveLeft(1)
+	})
+
+	want := "\x1b[H\x1b[999;999H\x1b[2A\x1b[1B\x1b[5C\x1b[1D"
+	if out != want {
+		t.Fatalf("unexpected movement sequences:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestPartialClearHelpers(t *testing.T) {
+	out := captureStdout(t, func() {
+		_, _ = ClearToEndOfLine()
+		_, _ = ClearToStartOfLine()
+		_, _ = EraseDisplay(0)
+		_, _ = EraseDisplay(1)
+		_, _ = ClearScreen()
+	})
+
+	want := "\x1b[0K\x1b[1K\x1b[0J\x1b[1J\x1b[2J"
+	if out != want {
+		t.Fatalf("unexpected clear sequences:\n got %q\nwant %q", out, want)
+	}
+
+	if _, err := EraseDisplay(5); err == nil {
+		t.Fatal("expected error for invalid erase mode")
+	}
+}
+
+func TestIndentRepeatsUnit(t *testing.T) {
+	Configure(Config{NoColor: true, TUI: TUIConfig{IndentUnit: "--"}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	if got := Indent(3); got != "------" {
+		t.Fatalf("expected three units, got %q", got)
+	}
+	if got := Indent(-1); got != "" {
+		t.Fatalf("expected empty indent for negative n, got %q", got)
+	}
+	Configure(Config{NoColor: true})
+	if got := Indent(1); got != "  " {
+		t.Fatalf("expected default two-space unit, got %q", got)
+	}
+}
+
+func TestIndentBlockIndentsLinesAndRestores(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		Println("top")
+		IndentBlock(1, func() {
+			Print("a")
+			Println("b")
+			IndentBlock(2, func() {
+				Println("deep\nlines")
+			})
+			Println("")
+			Println("c")
+		})
+		Println("bottom")
+	})
+
+	want := "top\n  ab\n      deep\n      lines\n\n  c\nbottom\n"
+	if out != want {
+		t.Fatalf("unexpected indented output:\n got %q\nwant %q", out, want)
+	}
+	if m := Configured().TUI.LeftMargin; m != 0 {
+		t.Fatalf("expected margin restored to 0, got %d", m)
+	}
+}
+
+func TestBadgeFallsBackToPromptColor(t *testing.T) {
+	prompt, green := StyleColor256(11), StyleColor256(Green)
+	Configure(Config{Colors: ConsoleColors{Prompt: prompt}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		_, _ = Badge("OK", green)
+		_, _ = Badge("v1.2", "")
+	})
+	if out != green+"[OK]"+StyleReset+prompt+"[v1.2]"+StyleReset {
+		t.Fatalf("unexpected badges %q", out)
+	}
+}
+
+func TestBadgeLineRespectsMaxWidth(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	badges := []BadgeItem{{Text: "OK"}, {Text: "WARN"}, {Text: "v1.2"}}
+	out := captureStdout(t, func() { _, _ = BadgeLine("api", badges) })
+	if out != "api: [OK] [WARN] [v1.2]" {
+		t.Fatalf("unexpected badge line %q", out)
+	}
+
+	Configure(Config{NoColor: true, TUI: TUIConfig{MaxWidth: 16}})
+	out = captureStdout(t, func() { _, _ = BadgeLine("api", badges) })
+	if out != "api: [OK] [WARN]" {
+		t.Fatalf("expected trailing badge dropped, got %q", out)
+	}
+}
+
+func TestNotificationWritesAtRowWithLevelColor(t *testing.T) {
+	warn := StyleColor256(Yellow)
+	Configure(Config{Colors: ConsoleColors{Warn: warn}, TUI: TUIConfig{MaxWidth: 5}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		if _, err := Notification(&NotificationParams{Message: "saved!!", Level: "warn", Row: 24}); err != nil {
+			t.Fatalf("notification: %v", err)
+		}
+	})
+
+	want := "\0337\x1b[24;1H\x1b[2K\r\x1b[24;1H" + warn + "saved" + StyleReset + "\0338"
+	if out != want {
+		t.Fatalf("unexpected notification:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestClearNotificationClearsRow(t *testing.T) {
+	out := captureStdout(t, func() {
+		if _, err := ClearNotification(3); err != nil {
+			t.Fatalf("clear: %v", err)
+		}
+	})
+	if out != "\x1b[3;1H\x1b[2K\r" {
+		t.Fatalf("unexpected clear sequence %q", out)
+	}
+}
@@ -0,0 +1,177 @@
+package logs
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const defaultSelectMaxRetries = 3
+
+// ErrSelectAborted is returned by Select when input closes or the retry
+// limit (Config.SelectMaxRetries) is exceeded.
+var ErrSelectAborted = errors.New("smplog: select aborted")
+
+// InputParams describes one labeled input row rendered with InputLine.
+type InputParams struct {
+	// Label is the prompt prefix, e.g. "name> ".
+	Label string
+	// Value is the default used when the user submits an empty line.
+	Value string
+	// Active marks the row currently receiving input.
+	Active bool
+}
+
+// InputOption configures interactive input helpers.
+type InputOption func(*inputOptions)
+
+type inputOptions struct {
+	in io.Reader
+}
+
+// WithInput reads from r instead of os.Stdin.
+func WithInput(r io.Reader) InputOption {
+	return func(o *inputOptions) { o.in = r }
+}
+
+func newInputOptions(opts []InputOption) inputOptions {
+	o := inputOptions{in: os.Stdin}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// Form renders each field as an input row and reads one line per field.
+// The active flag moves from field to field as lines are read; an empty
+// line keeps the field's Value as the default. Values are returned in
+// field order. io.EOF is returned if input closes before all fields are read.
+func Form(fields []*InputParams, opts ...InputOption) ([]string, error) {
+	o := newInputOptions(opts)
+	values := make([]string, 0, len(fields))
+	for i, field := range fields {
+		for j, other := range fields {
+			other.Active = j == i
+		}
+		if _, err := InputLine(field.Label, field.Value, true); err != nil {
+			return values, err
+		}
+		line, err := readLine(o.in)
+		if err != nil {
+			return values, err
+		}
+		if line == "" {
+			line = field.Value
+		}
+		values = append(values, line)
+		field.Active = false
+	}
+	return values, nil
+}
+
+// Confirm writes "prompt [y/N]: " with the prompt color and reads one line.
+// It returns true only for "y" or "yes" (case-insensitive). Closed input
+// returns false with no error.
+func Confirm(prompt string, opts ...InputOption) (bool, error) {
+	return ConfirmWithDefault(prompt, false, opts...)
+}
+
+// ConfirmWithDefault is Confirm with a default answer for empty input.
+// The hint is rendered as [Y/n] when defaultYes is true and [y/N] otherwise.
+func ConfirmWithDefault(prompt string, defaultYes bool, opts ...InputOption) (bool, error) {
+	o := newInputOptions(opts)
+	hint := "[y/N]"
+	if defaultYes {
+		hint = "[Y/n]"
+	}
+	if _, err := Promptf("%s %s: ", prompt, hint); err != nil {
+		return false, err
+	}
+	line, err := readLine(o.in)
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "":
+		return defaultYes, nil
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// Select renders items as a numbered menu, writes prompt, and reads a
+// 1-based choice. It returns the 0-based index of the chosen item.
+// Invalid input re-prompts up to Config.SelectMaxRetries times.
+func Select(prompt string, items []string, opts ...InputOption) (int, error) {
+	o := newInputOptions(opts)
+	cfg := Configured()
+	for i, item := range items {
+		if _, err := menuItem(cfg, i+1, item, false); err != nil {
+			return -1, err
+		}
+		if _, err := writeStdout(cfg, "\n"); err != nil {
+			return -1, err
+		}
+	}
+
+	for attempt := 0; attempt <= cfg.SelectMaxRetries; attempt++ {
+		if _, err := Prompt(prompt); err != nil {
+			return -1, err
+		}
+		line, err := readLine(o.in)
+		if errors.Is(err, io.EOF) {
+			return -1, ErrSelectAborted
+		}
+		if err != nil {
+			return -1, err
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(line))
+		if err == nil && n >= 1 && n <= len(items) {
+			return n - 1, nil
+		}
+	}
+	return -1, ErrSelectAborted
+}
+
+// inputSource and inputBuf buffer the most recent input source, so read-ahead
+// from piped input carries over to the next prompt instead of being lost
+// with a per-call scanner. Switching sources drops the previous buffer.
+var (
+	inputMu     sync.Mutex
+	inputSource io.Reader
+	inputBuf    *bufio.Reader
+)
+
+// readLine returns the next line from in without its line ending, or
+// io.EOF when input is exhausted. A final line without a newline is
+// returned before io.EOF.
+func readLine(in io.Reader) (string, error) {
+	inputMu.Lock()
+	defer inputMu.Unlock()
+	if inputBuf == nil || !reflect.TypeOf(in).Comparable() || in != inputSource {
+		inputSource, inputBuf = in, bufio.NewReader(in)
+	}
+	line, err := inputBuf.ReadString('\n')
+	if errors.Is(err, io.EOF) {
+		inputSource, inputBuf = nil, nil
+		if line != "" {
+			err = nil
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+}
@@ -0,0 +1,185 @@
+package logs
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFormReadsFieldsWithDefaults(t *testing.T) {
+	Configure(Config{NoColor: true, TUI: TUIConfig{InputCursor: "|"}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	fields := []*InputParams{
+		{Label: "name> "},
+		{Label: "port> ", Value: "8080"},
+	}
+
+	var values []string
+	out := captureStdout(t, func() {
+		var err error
+		values, err = Form(fields, WithInput(strings.NewReader("edge\n\n")))
+		if err != nil {
+			t.Fatalf("form: %v", err)
+		}
+	})
+
+	if len(values) != 2 || values[0] != "edge" || values[1] != "8080" {
+		t.Fatalf("unexpected values: %q", values)
+	}
+	if !strings.Contains(out, "name> |") || !strings.Contains(out, "port> 8080|") {
+		t.Fatalf("expected active input rows in output: %q", out)
+	}
+	for i, f := range fields {
+		if f.Active {
+			t.Fatalf("field %d still active after form completed", i)
+		}
+	}
+}
+
+func TestFormReturnsEOFWhenInputCloses(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	fields := []*InputParams{{Label: "a> "}, {Label: "b> "}}
+
+	captureStdout(t, func() {
+		values, err := Form(fields, WithInput(strings.NewReader("only-one\n")))
+		if !errors.Is(err, io.EOF) {
+			t.Fatalf("form: got err %v, want io.EOF", err)
+		}
+		if len(values) != 1 || values[0] != "only-one" {
+			t.Fatalf("unexpected partial values: %q", values)
+		}
+	})
+}
+
+func TestConfirmParsesAnswers(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	cases := []struct {
+		input      string
+		defaultYes bool
+		want       bool
+	}{
+		{"y\n", false, true},
+		{"YES\n", false, true},
+		{"n\n", false, false},
+		{"maybe\n", false, false},
+		{"\n", false, false},
+		{"\n", true, true},
+		{"no\n", true, false},
+		{"", true, false},
+	}
+	for _, c := range cases {
+		var got bool
+		out := captureStdout(t, func() {
+			var err error
+			got, err = ConfirmWithDefault("delete?", c.defaultYes, WithInput(strings.NewReader(c.input)))
+			if err != nil {
+				t.Fatalf("confirm(%q): %v", c.input, err)
+			}
+		})
+		if got != c.want {
+			t.Errorf("confirm(%q, defaultYes=%v): got %v, want %v", c.input, c.defaultYes, got, c.want)
+		}
+		wantPrompt := "delete? [y/N]: "
+		if c.defaultYes {
+			wantPrompt = "delete? [Y/n]: "
+		}
+		if out != wantPrompt {
+			t.Errorf("prompt: got %q, want %q", out, wantPrompt)
+		}
+	}
+
+	captureStdout(t, func() {
+		ok, err := Confirm("continue?", WithInput(strings.NewReader("y\n")))
+		if err != nil || !ok {
+			t.Fatalf("confirm: got %v, %v", ok, err)
+		}
+	})
+}
+
+func TestSelectRetriesThenAborts(t *testing.T) {
+	Configure(Config{NoColor: true, SelectMaxRetries: 2})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	items := []string{"start", "stop", "status"}
+
+	var idx int
+	out := captureStdout(t, func() {
+		var err error
+		idx, err = Select("pick> ", items, WithInput(strings.NewReader("x\n9\n2\n")))
+		if err != nil {
+			t.Fatalf("select: %v", err)
+		}
+	})
+	if idx != 1 {
+		t.Fatalf("select: got %d, want 1", idx)
+	}
+	if !strings.Contains(out, "3) status") || strings.Count(out, "pick> ") != 3 {
+		t.Fatalf("expected menu and three prompts in output: %q", out)
+	}
+
+	captureStdout(t, func() {
+		_, err := Select("pick> ", items, WithInput(strings.NewReader("a\nb\nc\n2\n")))
+		if !errors.Is(err, ErrSelectAborted) {
+			t.Fatalf("select after retries: got %v, want ErrSelectAborted", err)
+		}
+		_, err = Select("pick> ", items, WithInput(strings.NewReader("")))
+		if !errors.Is(err, ErrSelectAborted) {
+			t.Fatalf("select on EOF: got %v, want ErrSelectAborted", err)
+		}
+	})
+}
+
+func TestPasswordReadsNonTerminalInput(t *testing.T) {
+	Configure(Config{NoColor: true, RequirePassword: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		got, err := Password("password: ", WithInput(strings.NewReader("hunter2\n")))
+		if err != nil || got != "hunter2" {
+			t.Fatalf("password: got %q, %v", got, err)
+		}
+		got, err = PasswordWithMask("pin: ", "*", WithInput(strings.NewReader("1234\n")))
+		if err != nil || got != "1234" {
+			t.Fatalf("password with mask: got %q, %v", got, err)
+		}
+		_, err = Password("password: ", WithInput(strings.NewReader("\n")))
+		if !errors.Is(err, ErrPasswordEmpty) {
+			t.Fatalf("empty password: got %v, want ErrPasswordEmpty", err)
+		}
+	})
+
+	if out != "password: pin: password: " {
+		t.Fatalf("unexpected prompt output: %q", out)
+	}
+}
+
+func TestPromptsShareBufferedInput(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	in := WithInput(strings.NewReader("y\n2\nadmin\nhunter2\n"))
+	captureStdout(t, func() {
+		ok, err := Confirm("continue?", in)
+		if err != nil || !ok {
+			t.Fatalf("confirm: got %v, %v", ok, err)
+		}
+		idx, err := Select("pick> ", []string{"a", "b"}, in)
+		if err != nil || idx != 1 {
+			t.Fatalf("select: got %d, %v", idx, err)
+		}
+		values, err := Form([]*InputParams{{Label: "user> "}}, in)
+		if err != nil || len(values) != 1 || values[0] != "admin" {
+			t.Fatalf("form: got %q, %v", values, err)
+		}
+		secret, err := Password("password: ", in)
+		if err != nil || secret != "hunter2" {
+			t.Fatalf("password: got %q, %v", secret, err)
+		}
+	})
+}
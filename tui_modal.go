@@ -0,0 +1,107 @@
+package logs
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ModalParams describes a bordered box rendered with Modal.
+type ModalParams struct {
+	Title string
+	Body  string
+	// Width is the number of columns between the side borders. Zero uses
+	// TUI.DividerWidth; TUI.MaxWidth caps the whole box including borders.
+	Width int
+	// PaddingH is the number of spaces between each side border and the body.
+	PaddingH int
+	// TitleColor and BodyColor override the title and data colors.
+	TitleColor string
+	BodyColor  string
+}
+
+// Modal writes a double-line box with Title set into the top border and
+// Body word-wrapped at Width-2*PaddingH columns:
+//
+//	╔══ Title ═════╗
+//	║ body text    ║
+//	╚══════════════╝
+//
+// Borders use the divider color, the title the title color and the body
+// the data color unless overridden. It is a no-op when p is nil.
+func Modal(p *ModalParams) (int, error) {
+	if p == nil {
+		return 0, nil
+	}
+	cfg := Configured()
+	width := p.Width
+	if width <= 0 {
+		width = cfg.TUI.DividerWidth
+	}
+	if cfg.TUI.MaxWidth > 0 {
+		width = min(width, cfg.TUI.MaxWidth-2)
+	}
+	width = max(width, 1)
+	pad := min(max(p.PaddingH, 0), (width-1)/2)
+	titleColor := firstNonEmpty(p.TitleColor, cfg.Colors.title())
+	bodyColor := firstNonEmpty(p.BodyColor, cfg.Colors.data())
+	border := func(s string) string { return colorize(cfg.Colors.divider(), s, cfg.NoColor) }
+
+	var b strings.Builder
+	if title := Clip(width-4, p.Title); title != "" {
+		b.WriteString(border("╔══ "))
+		b.WriteString(colorize(titleColor, title, cfg.NoColor))
+		b.WriteString(border(" " + strings.Repeat("═", width-4-utf8.RuneCountInString(title)) + "╗"))
+	} else {
+		b.WriteString(border("╔" + strings.Repeat("═", width) + "╗"))
+	}
+	b.WriteByte('\n')
+	inner := width - 2*pad
+	for _, line := range wrapText(p.Body, inner) {
+		b.WriteString(border("║"))
+		b.WriteString(strings.Repeat(" ", pad))
+		b.WriteString(colorize(bodyColor, PadRight(inner, line), cfg.NoColor))
+		b.WriteString(strings.Repeat(" ", pad))
+		b.WriteString(border("║"))
+		b.WriteByte('\n')
+	}
+	b.WriteString(border("╚" + strings.Repeat("═", width) + "╝"))
+	b.WriteByte('\n')
+	return writeStdout(cfg, b.String())
+}
+
+// wrapText splits s into lines of at most width runes, breaking at spaces
+// where possible and hard-breaking longer words. Newlines in s start new
+// lines; an empty s yields no lines.
+func wrapText(s string, width int) []string {
+	if s == "" || width <= 0 {
+		return nil
+	}
+	var lines []string
+	for para := range strings.SplitSeq(s, "\n") {
+		line, n := "", 0
+		for _, word := range strings.Fields(para) {
+			for utf8.RuneCountInString(word) > width {
+				if n > 0 {
+					lines = append(lines, line)
+					line, n = "", 0
+				}
+				head := Clip(width, word)
+				lines = append(lines, head)
+				word = word[len(head):]
+			}
+			wn := utf8.RuneCountInString(word)
+			switch {
+			case wn == 0:
+			case n == 0:
+				line, n = word, wn
+			case n+1+wn <= width:
+				line, n = line+" "+word, n+1+wn
+			default:
+				lines = append(lines, line)
+				line, n = word, wn
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
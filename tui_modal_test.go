@@ -0,0 +1,64 @@
+package logs
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestModalRendersBorderedBox(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		_, err := Modal(&ModalParams{Title: "Confirm", Body: "Delete all files now?", Width: 14, PaddingH: 1})
+		if err != nil {
+			t.Fatalf("modal: %v", err)
+		}
+	})
+
+	want := strings.Join([]string{
+		"╔══ Confirm ═══╗",
+		"║ Delete all   ║",
+		"║ files now?   ║",
+		"╚══════════════╝",
+	}, "\n") + "\n"
+	if out != want {
+		t.Fatalf("unexpected modal:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestModalColorsAndMaxWidth(t *testing.T) {
+	div, title, body := StyleColor256(8), StyleColor256(9), StyleColor256(10)
+	Configure(Config{
+		Colors: ConsoleColors{Divider: div, Title: title},
+		TUI:    TUIConfig{MaxWidth: 12},
+	})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		_, _ = Modal(&ModalParams{Title: "Hi", Body: "x", BodyColor: body})
+	})
+
+	for _, want := range []string{div + "╔══ " + StyleReset, title + "Hi" + StyleReset, body + "x         " + StyleReset, div + "║" + StyleReset} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in %q", want, out)
+		}
+	}
+	for line := range strings.Lines(StripANSI(out)) {
+		if n := len([]rune(strings.TrimSuffix(line, "\n"))); n != 12 {
+			t.Fatalf("expected 12-column lines, got %d in %q", n, line)
+		}
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	got := wrapText("a bb ccc\n\nsupercalifragilistic end", 6)
+	want := []string{"a bb", "ccc", "", "superc", "alifra", "gilist", "ic end"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("unexpected wrap:\n got %q\nwant %q", got, want)
+	}
+	if wrapText("", 10) != nil {
+		t.Fatal("expected no lines for empty text")
+	}
+}
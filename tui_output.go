@@ -0,0 +1,93 @@
+package logs
+
+import (
+	"io"
+	"os"
+	"sync"
+)
+
+// tuiOut is the package-level destination of the print and TUI helpers set
+// by ConfigureTUI. nil means os.Stdout.
+var (
+	tuiOutMu sync.RWMutex
+	tuiOut   io.Writer
+)
+
+// TUIOption configures where TUI helper output is written; see ConfigureTUI
+// and NewTUI.
+type TUIOption func(*tuiOptions)
+
+type tuiOptions struct {
+	out io.Writer
+}
+
+// WithTUIWriter writes TUI helper output to w instead of os.Stdout. A nil w
+// selects os.Stdout.
+func WithTUIWriter(w io.Writer) TUIOption {
+	return func(o *tuiOptions) { o.out = w }
+}
+
+func newTUIOptions(opts []TUIOption) tuiOptions {
+	var o tuiOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// ConfigureTUI applies opts to every print and TUI helper (Menu, Title,
+// Divider, MoveTo, WriteAt, BatchWrite, Render, ...), e.g. to draw a menu
+// into a net.Conn. ConfigureTUI() with no options restores os.Stdout.
+// Password echo control still uses the terminal directly.
+func ConfigureTUI(opts ...TUIOption) {
+	o := newTUIOptions(opts)
+	tuiOutMu.Lock()
+	defer tuiOutMu.Unlock()
+	tuiOut = o.out
+}
+
+// TUI renders components to its own writer, leaving the package-level TUI
+// output untouched. Create one with NewTUI or NewTUIWithWriter.
+type TUI struct {
+	out io.Writer
+}
+
+// NewTUI returns a TUI configured by opts. Without WithTUIWriter it renders
+// to the package-level TUI output.
+func NewTUI(opts ...TUIOption) TUI {
+	return TUI{out: newTUIOptions(opts).out}
+}
+
+// NewTUIWithWriter returns a TUI that renders to w, e.g. an
+// http.ResponseWriter or bytes.Buffer.
+func NewTUIWithWriter(w io.Writer) TUI {
+	return NewTUI(WithTUIWriter(w))
+}
+
+// Render renders components in order against the active config, like the
+// package-level Render, writing to t's writer. Built-in components and any
+// component that writes through the Config it is given are routed;
+// package-level helpers called directly from a ComponentFunc use the
+// package-level output, as does a component that passes its Config to
+// Configure.
+func (t TUI) Render(components []Component) error {
+	cfg := Configured()
+	cfg.tuiOut = t.out
+	return renderAll(cfg, components)
+}
+
+// tuiOutput returns the writer for cfg's TUI output: cfg's own writer when
+// set by TUI.Render, else the ConfigureTUI writer, else os.Stdout.
+func tuiOutput(cfg Config) io.Writer {
+	if cfg.tuiOut != nil {
+		return cfg.tuiOut
+	}
+	tuiOutMu.RLock()
+	defer tuiOutMu.RUnlock()
+	if tuiOut != nil {
+		return tuiOut
+	}
+	return os.Stdout
+}
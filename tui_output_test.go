@@ -0,0 +1,76 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestTUIWithWriterRendersToWriter verifies NewTUIWithWriter routes component output away from stdout.
+func TestTUIWithWriterRendersToWriter(t *testing.T) {
+	Configure(Config{NoColor: true, TUI: TUIConfig{DividerWidth: 3}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var buf bytes.Buffer
+	tui := NewTUIWithWriter(&buf)
+	stdout := captureStdout(t, func() {
+		err := tui.Render([]Component{VStack(TitleComponent{Text: "Main"}, DividerComponent{})})
+		if err != nil {
+			t.Fatalf("render: %v", err)
+		}
+	})
+
+	if stdout != "" {
+		t.Fatalf("expected nothing on stdout, got %q", stdout)
+	}
+	if want := "Main\n---\n"; buf.String() != want {
+		t.Fatalf("got %q, want %q", buf.String(), want)
+	}
+}
+
+// TestConfigureTUIRoutesHelpers verifies ConfigureTUI redirects the package-level helpers until reset.
+func TestConfigureTUIRoutesHelpers(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var buf bytes.Buffer
+	ConfigureTUI(WithTUIWriter(&buf))
+	t.Cleanup(func() { ConfigureTUI() })
+
+	stdout := captureStdout(t, func() {
+		Title("Main")
+		WriteAt(2, 3, "", "x")
+	})
+	if stdout != "" {
+		t.Fatalf("expected nothing on stdout, got %q", stdout)
+	}
+	if want := "Main\x1b[2;3Hx"; buf.String() != want {
+		t.Fatalf("got %q, want %q", buf.String(), want)
+	}
+
+	ConfigureTUI()
+	if out := captureStdout(t, func() { Title("back") }); out != "back" {
+		t.Fatalf("expected stdout after reset, got %q", out)
+	}
+}
+
+// TestTUIWriterDoesNotLeakThroughConfigure verifies a component re-applying its Config keeps output global.
+func TestTUIWriterDoesNotLeakThroughConfigure(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	var buf bytes.Buffer
+	reconfigure := ComponentFunc(func(cfg Config) error {
+		Configure(cfg)
+		return nil
+	})
+	if err := NewTUIWithWriter(&buf).Render([]Component{reconfigure}); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	if out := captureStdout(t, func() { Title("after") }); out != "after" {
+		t.Fatalf("expected stdout after the render, got %q", out)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected nothing in the render writer, got %q", buf.String())
+	}
+}
@@ -0,0 +1,49 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrPasswordEmpty is returned by Password and PasswordWithMask when the
+// submitted value is empty and Config.RequirePassword is true.
+var ErrPasswordEmpty = errors.New("smplog: password is empty")
+
+// Password writes prompt with the prompt color and reads a line without
+// echoing it, then writes a newline. When input is not a terminal the line
+// is read normally.
+func Password(prompt string, opts ...InputOption) (string, error) {
+	return readSecret(prompt, "", opts)
+}
+
+// PasswordWithMask is Password but echoes mask for each typed character.
+// Backspace removes the last character.
+func PasswordWithMask(prompt, mask string, opts ...InputOption) (string, error) {
+	return readSecret(prompt, mask, opts)
+}
+
+func readSecret(prompt, mask string, opts []InputOption) (string, error) {
+	o := newInputOptions(opts)
+	if _, err := Prompt(prompt); err != nil {
+		return "", err
+	}
+
+	var secret string
+	var err error
+	if f, ok := terminalFile(o.in); ok {
+		secret, err = readTerminalSecret(f, mask)
+		if _, werr := fmt.Fprint(os.Stdout, "\n"); err == nil {
+			err = werr
+		}
+	} else {
+		secret, err = readLine(o.in)
+	}
+	if err != nil {
+		return "", err
+	}
+	if secret == "" && Configured().RequirePassword {
+		return "", ErrPasswordEmpty
+	}
+	return secret, nil
+}
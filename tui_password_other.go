@@ -0,0 +1,20 @@
+//go:build !unix && !windows
+
+package logs
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+// terminalFile always reports false: terminal echo control is unavailable on
+// this platform, so password input falls back to a normal line read.
+func terminalFile(r io.Reader) (*os.File, bool) {
+	Warn("smplog: password input will be echoed; terminal control is unavailable on this platform")
+	return nil, false
+}
+
+func readTerminalSecret(in *os.File, mask string) (string, error) {
+	return "", errors.ErrUnsupported
+}
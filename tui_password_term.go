@@ -0,0 +1,68 @@
+//go:build unix || windows
+
+package logs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// terminalFile reports r as an *os.File when r is an interactive terminal.
+func terminalFile(r io.Reader) (*os.File, bool) {
+	f, ok := r.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return nil, false
+	}
+	return f, true
+}
+
+// readTerminalSecret reads one line from the terminal without echo.
+// A non-empty mask is echoed once per typed character.
+func readTerminalSecret(in *os.File, mask string) (string, error) {
+	fd := int(in.Fd())
+	if mask == "" {
+		b, err := term.ReadPassword(fd)
+		return string(b), err
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return "", err
+	}
+	defer term.Restore(fd, state)
+
+	var secret []rune
+	var buf [4]byte
+	var pending []byte
+	erase := strings.Repeat("\b \b", utf8.RuneCountInString(mask))
+	for {
+		n, err := in.Read(buf[:])
+		if err != nil {
+			return "", err
+		}
+		pending = append(pending, buf[:n]...)
+		for len(pending) > 0 && utf8.FullRune(pending) {
+			r, size := utf8.DecodeRune(pending)
+			pending = pending[size:]
+			switch r {
+			case '\r', '\n':
+				return string(secret), nil
+			case 3: // Ctrl-C
+				return "", io.EOF
+			case 8, 127: // backspace, delete
+				if len(secret) > 0 {
+					secret = secret[:len(secret)-1]
+					fmt.Fprint(os.Stdout, erase)
+				}
+			default:
+				secret = append(secret, r)
+				fmt.Fprint(os.Stdout, mask)
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+//go:build unix
+
+package logs
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// terminalSize reports the current stdout terminal dimensions.
+// It is a variable so tests can run without a real terminal.
+var terminalSize = func() (width, height int, err error) {
+	ws, err := unix.IoctlGetWinsize(int(os.Stdout.Fd()), unix.TIOCGWINSZ)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(ws.Col), int(ws.Row), nil
+}
+
+// WatchTerminalSize installs a SIGWINCH handler that updates
+// Config.TUI.MaxWidth and calls onChange with the new terminal dimensions
+// each time the terminal is resized. Signals are ignored while the size
+// cannot be read (e.g. stdout is not a terminal).
+//
+// Each caller gets its own handler; the returned func unregisters it.
+func WatchTerminalSize(onChange func(width, height int)) (func(), error) {
+	sigs := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigs, unix.SIGWINCH)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-sigs:
+				width, height, err := terminalSize()
+				if err != nil {
+					continue
+				}
+				setTUIMaxWidth(width)
+				if onChange != nil {
+					onChange(width, height)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(sigs)
+			close(done)
+		})
+	}, nil
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package logs
+
+import "errors"
+
+// WatchTerminalSize is not supported on this platform, which has no
+// SIGWINCH. It returns a no-op stop func and errors.ErrUnsupported.
+func WatchTerminalSize(onChange func(width, height int)) (func(), error) {
+	return func() {}, errors.ErrUnsupported
+}
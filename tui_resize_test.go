@@ -0,0 +1,61 @@
+//go:build unix
+
+package logs
+
+import (
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatchTerminalSizeFiresOnSIGWINCH(t *testing.T) {
+	Configure(DefaultConfig())
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	oldSize := terminalSize
+	terminalSize = func() (int, int, error) { return 100, 40, nil }
+	t.Cleanup(func() { terminalSize = oldSize })
+
+	type size struct{ w, h int }
+	first := make(chan size, 1)
+	second := make(chan size, 1)
+
+	stop1, err := WatchTerminalSize(func(w, h int) {
+		select {
+		case first <- size{w, h}:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	defer stop1()
+	stop2, err := WatchTerminalSize(func(w, h int) {
+		select {
+		case second <- size{w, h}:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	defer stop2()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGWINCH); err != nil {
+		t.Fatalf("send SIGWINCH: %v", err)
+	}
+
+	for i, ch := range []chan size{first, second} {
+		select {
+		case got := <-ch:
+			if got != (size{100, 40}) {
+				t.Fatalf("watcher %d: got %+v, want 100x40", i, got)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("watcher %d: callback did not fire", i)
+		}
+	}
+	if got := Configured().TUI.MaxWidth; got != 100 {
+		t.Fatalf("TUI.MaxWidth: got %d, want 100", got)
+	}
+}
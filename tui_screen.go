@@ -0,0 +1,125 @@
+package logs
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Screen tracks named rectangular regions of a full-screen TUI so each
+// region can be redrawn independently without repainting the whole frame.
+type Screen struct {
+	mu      sync.Mutex
+	regions map[string]*screenRegion
+	order   []string
+}
+
+type screenRegion struct {
+	row, col      int
+	height, width int
+	render        func()
+}
+
+// NewScreen returns an empty Screen.
+func NewScreen() *Screen {
+	return &Screen{regions: make(map[string]*screenRegion)}
+}
+
+// AddRegion registers (or replaces) a region with a 1-based origin and size.
+func (s *Screen) AddRegion(name string, row, col, height, width int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.regions[name]; !ok {
+		s.order = append(s.order, name)
+	}
+	s.regions[name] = &screenRegion{
+		row:    maxOne(row),
+		col:    maxOne(col),
+		height: max(height, 0),
+		width:  max(width, 0),
+	}
+}
+
+// UpdateRegion blanks the named region, moves the cursor to its origin,
+// calls fn to render the content, then restores the previous cursor position.
+// fn is remembered so Clear can re-render the region.
+func (s *Screen) UpdateRegion(name string, fn func()) error {
+	s.mu.Lock()
+	r, ok := s.regions[name]
+	var region screenRegion
+	if ok {
+		r.render = fn
+		region = *r
+	}
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("smplog: unknown screen region %q", name)
+	}
+	return region.draw()
+}
+
+// Clear calls Refresh and re-renders every region that has been updated,
+// in registration order.
+func (s *Screen) Clear() error {
+	s.mu.Lock()
+	regions := make([]screenRegion, 0, len(s.order))
+	for _, name := range s.order {
+		if r := s.regions[name]; r.render != nil {
+			regions = append(regions, *r)
+		}
+	}
+	s.mu.Unlock()
+
+	if err := Refresh(); err != nil {
+		return err
+	}
+	for _, r := range regions {
+		if err := r.draw(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Row returns the origin row of the named region, or 0 if it is not registered.
+func (s *Screen) Row(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.regions[name]; ok {
+		return r.row
+	}
+	return 0
+}
+
+// Col returns the origin column of the named region, or 0 if it is not registered.
+func (s *Screen) Col(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.regions[name]; ok {
+		return r.col
+	}
+	return 0
+}
+
+func (r *screenRegion) draw() error {
+	if _, err := SaveCursor(); err != nil {
+		return err
+	}
+	blank := strings.Repeat(" ", r.width)
+	for i := 0; i < r.height && r.width > 0; i++ {
+		if _, err := MoveTo(r.row+i, r.col); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(tuiOutput(Configured()), blank); err != nil {
+			return err
+		}
+	}
+	if _, err := MoveTo(r.row, r.col); err != nil {
+		return err
+	}
+	if r.render != nil {
+		r.render()
+	}
+	_, err := RestoreCursor()
+	return err
+}
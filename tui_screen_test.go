@@ -0,0 +1,69 @@
+package logs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScreenUpdateRegionWritesCursorSequences(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	s := NewScreen()
+	s.AddRegion("status", 4, 10, 2, 3)
+
+	if s.Row("status") != 4 || s.Col("status") != 10 {
+		t.Fatalf("unexpected origin: row=%d col=%d", s.Row("status"), s.Col("status"))
+	}
+	if s.Row("missing") != 0 || s.Col("missing") != 0 {
+		t.Fatal("expected zero origin for unknown region")
+	}
+
+	out := captureStdout(t, func() {
+		if err := s.UpdateRegion("status", func() { Print("ok") }); err != nil {
+			t.Fatalf("update region: %v", err)
+		}
+	})
+
+	want := "\x1b7\x1b[4;10H   \x1b[5;10H   \x1b[4;10Hok\x1b8"
+	if out != want {
+		t.Fatalf("unexpected region output:\n got %q\nwant %q", out, want)
+	}
+
+	if err := s.UpdateRegion("missing", func() {}); err == nil {
+		t.Fatal("expected error for unknown region")
+	}
+}
+
+func TestScreenClearRerendersRegions(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	s := NewScreen()
+	s.AddRegion("header", 1, 1, 0, 0)
+	s.AddRegion("footer", 20, 1, 0, 0)
+	s.AddRegion("unused", 10, 1, 0, 0)
+
+	captureStdout(t, func() {
+		_ = s.UpdateRegion("header", func() { Print("head") })
+		_ = s.UpdateRegion("footer", func() { Print("foot") })
+	})
+
+	out := captureStdout(t, func() {
+		if err := s.Clear(); err != nil {
+			t.Fatalf("clear: %v", err)
+		}
+	})
+
+	if !strings.HasPrefix(out, "\x1b[2J\x1b[1;1H") {
+		t.Fatalf("expected refresh sequence first: %q", out)
+	}
+	head := strings.Index(out, "\x1b[1;1Hhead")
+	foot := strings.Index(out, "\x1b[20;1Hfoot")
+	if head < 0 || foot < 0 || head > foot {
+		t.Fatalf("expected header then footer re-render: %q", out)
+	}
+	if strings.Contains(out, "\x1b[10;1H") {
+		t.Fatalf("expected never-updated region to be skipped: %q", out)
+	}
+}
@@ -0,0 +1,88 @@
+package logs
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// TreeNode is one entry rendered by Tree.
+type TreeNode struct {
+	Label    string
+	Children []*TreeNode
+	// Selected renders the node with the title color.
+	Selected bool
+}
+
+// TreeParams describes a tree rendered with Tree.
+type TreeParams struct {
+	Root *TreeNode
+	// IndentRune draws the horizontal part of branch connectors. Defaults
+	// to '─'.
+	IndentRune rune
+	// Width clips each line to this many runes. Zero uses TUI.MaxWidth;
+	// a non-zero TUI.MaxWidth always caps it.
+	Width int
+}
+
+// Tree writes p.Root and its descendants depth-first, one node per line,
+// joined by box-drawing connectors:
+//
+//	root
+//	├── a
+//	│   └── a1
+//	└── b
+//
+// Leaf labels use the data color, nodes with children the menu color and
+// selected nodes the title color. It is a no-op when p or p.Root is nil.
+func Tree(p *TreeParams) (int, error) {
+	if p == nil || p.Root == nil {
+		return 0, nil
+	}
+	cfg := Configured()
+	width := p.Width
+	if cfg.TUI.MaxWidth > 0 && (width <= 0 || width > cfg.TUI.MaxWidth) {
+		width = cfg.TUI.MaxWidth
+	}
+	r := p.IndentRune
+	if r == 0 {
+		r = '─'
+	}
+	bar := strings.Repeat(string(r), 2)
+
+	var b strings.Builder
+	appendTreeNode(&b, cfg, p.Root, "", width)
+	var walk func(n *TreeNode, indent string)
+	walk = func(n *TreeNode, indent string) {
+		children := slices.DeleteFunc(slices.Clone(n.Children), func(c *TreeNode) bool { return c == nil })
+		for i, child := range children {
+			connector, next := "├"+bar+" ", "│   "
+			if i == len(children)-1 {
+				connector, next = "└"+bar+" ", "    "
+			}
+			appendTreeNode(&b, cfg, child, indent+connector, width)
+			walk(child, indent+next)
+		}
+	}
+	walk(p.Root, "")
+	return writeStdout(cfg, b.String())
+}
+
+// appendTreeNode writes one tree line, clipping prefix+label to width.
+func appendTreeNode(b *strings.Builder, cfg Config, n *TreeNode, prefix string, width int) {
+	label := n.Label
+	if width > 0 {
+		prefix = Clip(width, prefix)
+		label = Clip(width-utf8.RuneCountInString(prefix), label)
+	}
+	color := cfg.Colors.data()
+	if len(n.Children) > 0 {
+		color = cfg.Colors.menu()
+	}
+	if n.Selected {
+		color = cfg.Colors.title()
+	}
+	b.WriteString(prefix)
+	b.WriteString(colorize(color, label, cfg.NoColor))
+	b.WriteByte('\n')
+}
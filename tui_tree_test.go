@@ -0,0 +1,91 @@
+package logs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTreeSingleNode(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	out := captureStdout(t, func() {
+		if _, err := Tree(&TreeParams{Root: &TreeNode{Label: "root"}}); err != nil {
+			t.Fatalf("tree: %v", err)
+		}
+	})
+	if out != "root\n" {
+		t.Fatalf("unexpected output %q", out)
+	}
+}
+
+func TestTreeTwoLevels(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	root := &TreeNode{Label: "src", Children: []*TreeNode{
+		{Label: "main.go"},
+		nil,
+		{Label: "util.go"},
+		nil,
+	}}
+	out := captureStdout(t, func() { _, _ = Tree(&TreeParams{Root: root}) })
+
+	want := "src\n├── main.go\n└── util.go\n"
+	if out != want {
+		t.Fatalf("unexpected tree:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestTreeDeepUsesIndentRuneAndWidth(t *testing.T) {
+	Configure(Config{NoColor: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	root := &TreeNode{Label: "a", Children: []*TreeNode{
+		{Label: "b", Children: []*TreeNode{
+			{Label: "c", Children: []*TreeNode{{Label: "d"}}},
+		}},
+		{Label: "e", Children: []*TreeNode{{Label: "f-long-label"}}},
+	}}
+	out := captureStdout(t, func() { _, _ = Tree(&TreeParams{Root: root, IndentRune: '-', Width: 12}) })
+
+	want := strings.Join([]string{
+		"a",
+		"├-- b",
+		"│   └-- c",
+		"│       └-- ",
+		"└-- e",
+		"    └-- f-lo",
+	}, "\n") + "\n"
+	if out != want {
+		t.Fatalf("unexpected tree:\n got %q\nwant %q", out, want)
+	}
+}
+
+func TestTreeColorsByNodeKind(t *testing.T) {
+	menu, data, title := StyleColor256(1), StyleColor256(2), StyleColor256(3)
+	Configure(Config{Colors: ConsoleColors{Menu: menu, Data: data, Title: title}})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	root := &TreeNode{Label: "dir", Children: []*TreeNode{
+		{Label: "leaf"},
+		{Label: "picked", Selected: true},
+	}}
+	out := captureStdout(t, func() { _, _ = Tree(&TreeParams{Root: root}) })
+
+	for _, want := range []string{menu + "dir", data + "leaf", title + "picked"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in %q", want, out)
+		}
+	}
+}
+
+func TestTreeNilIsNoop(t *testing.T) {
+	out := captureStdout(t, func() {
+		_, _ = Tree(nil)
+		_, _ = Tree(&TreeParams{})
+	})
+	if out != "" {
+		t.Fatalf("expected no output, got %q", out)
+	}
+}
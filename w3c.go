@@ -0,0 +1,118 @@
+package logs
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// NewW3CWriter wraps w so JSON log lines are written in W3C Extended Log
+// Format. The first Write emits the "#Version" and "#Fields" directives,
+// then each line becomes space-separated values of fields in order:
+//
+//	#Version: 1.0
+//	#Fields: date time level message status
+//	2024-01-02 03:04:05 info request+served 200
+//
+// The "date" and "time" columns are split from the timestamp field in UTC;
+// other names select JSON keys. Missing or empty values are written as "-"
+// and spaces within values as "+". Input is split on newlines, and Close
+// converts a trailing line that had no newline. Close does not close w.
+func NewW3CWriter(w io.Writer, fields []string) io.WriteCloser {
+	return &w3cWriter{w: w, fields: append([]string(nil), fields...)}
+}
+
+type w3cWriter struct {
+	w      io.Writer
+	fields []string
+
+	mu      sync.Mutex
+	started bool
+	pending []byte
+}
+
+func (w *w3cWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pending = append(w.pending, p...)
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := w.pending[:i]
+		w.pending = w.pending[i+1:]
+		if err := w.writeLine(line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Close writes any buffered partial line, and the header when nothing has
+// been written yet.
+func (w *w3cWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	line := w.pending
+	w.pending = nil
+	if len(bytes.TrimSpace(line)) > 0 {
+		return w.writeLine(line)
+	}
+	if !w.started {
+		return w.writeHeader()
+	}
+	return nil
+}
+
+func (w *w3cWriter) writeHeader() error {
+	w.started = true
+	_, err := io.WriteString(w.w, "#Version: 1.0\n#Fields: "+strings.Join(w.fields, " ")+"\n")
+	return err
+}
+
+func (w *w3cWriter) writeLine(line []byte) error {
+	if !w.started {
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+	}
+	parsed, err := parseJSONObject(line)
+	if err != nil {
+		parsed = []jsonField{{Key: zerolog.MessageFieldName, Value: jsonString(string(bytes.TrimRight(line, "\r")))}}
+	}
+	values := make(map[string]string, len(parsed)+2)
+	for _, f := range parsed {
+		values[f.Key] = prettyText(f.Value)
+		if f.Key == zerolog.TimestampFieldName {
+			if t, ok := parseTimestampField(f.Value); ok {
+				t = t.UTC()
+				values["date"] = t.Format(time.DateOnly)
+				values["time"] = t.Format(time.TimeOnly)
+			}
+		}
+	}
+	cols := make([]string, len(w.fields))
+	for i, name := range w.fields {
+		cols[i] = w3cValue(values[name])
+	}
+	_, err = io.WriteString(w.w, strings.Join(cols, " ")+"\n")
+	return err
+}
+
+// w3cValue returns s as a W3C field value: "-" when empty, with whitespace
+// replaced by '+'.
+func w3cValue(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\t' || r == '\n' || r == '\r' {
+			return '+'
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,59 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestW3CWriterWritesHeaderAndColumns verifies the header, date/time split and missing fields.
+func TestW3CWriterWritesHeaderAndColumns(t *testing.T) {
+	var out bytes.Buffer
+	w := NewW3CWriter(&out, []string{"date", "time", "level", "message", "status"})
+	w.Write([]byte(`{"level":"info","status":200,"time":"2024-01-02T05:04:05+02:00","message":"request served"}` + "\n"))
+	w.Write([]byte(`{"level":"warn","message":"no time"}` + "\n"))
+
+	want := "#Version: 1.0\n#Fields: date time level message status\n" +
+		"2024-01-02 03:04:05 info request+served 200\n" +
+		"- - warn no+time -\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
+
+// TestW3CWriterCloseFlushesPartialLine verifies buffered input is written on Close.
+func TestW3CWriterCloseFlushesPartialLine(t *testing.T) {
+	var out bytes.Buffer
+	w := NewW3CWriter(&out, []string{"level", "message"})
+	w.Write([]byte(`{"level":"error",`))
+	if out.Len() != 0 {
+		t.Fatalf("expected nothing written for a partial line, got %q", out.String())
+	}
+	w.Write([]byte(`"message":"late"}`))
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	want := "#Version: 1.0\n#Fields: level message\nerror late\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+
+	var empty bytes.Buffer
+	NewW3CWriter(&empty, []string{"message"}).Close()
+	if empty.String() != "#Version: 1.0\n#Fields: message\n" {
+		t.Fatalf("expected header only on Close without writes, got %q", empty.String())
+	}
+}
+
+// TestW3CWriterWithLogger verifies use as a bypass-mode Config.Writer.
+func TestW3CWriterWithLogger(t *testing.T) {
+	var out bytes.Buffer
+	w := NewW3CWriter(&out, []string{"level", "message", "user"})
+	Configure(Config{Writer: w, Level: InfoLevel, Bypass: true})
+	t.Cleanup(func() { Configure(DefaultConfig()) })
+
+	Zerolog().Info().Str("user", "bob").Msg("login")
+	want := "#Version: 1.0\n#Fields: level message user\ninfo login bob\n"
+	if out.String() != want {
+		t.Fatalf("unexpected output:\n got %q\nwant %q", out.String(), want)
+	}
+}
@@ -1,6 +1,7 @@
 package logs
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -96,6 +97,27 @@ func Arr() *Array {
 	return zerolog.Arr()
 }
 
+// Stringer returns an info event on the global logger with val.String()
+// under key (null when val is nil):
+//
+//	logs.Stringer("addr", netip.MustParseAddr("10.0.0.1")).Msg("listening")
+func Stringer(key string, val fmt.Stringer) *Event {
+	return Zerolog().Info().Stringer(key, val)
+}
+
+// DictObject returns an info event on the global logger with obj encoded
+// as a nested object under key. (Dict, which builds a sub-dictionary
+// event, keeps its zerolog meaning.)
+func DictObject(key string, obj LogObjectMarshaler) *Event {
+	return Zerolog().Info().Object(key, obj)
+}
+
+// RawJSON returns an info event on the global logger with b embedded
+// verbatim under key. b must be valid JSON.
+func RawJSON(key string, b []byte) *Event {
+	return Zerolog().Info().RawJSON(key, b)
+}
+
 // ParseLevel parses text into a log level.
 func ParseLevel(level string) (Level, error) {
 	return zerolog.ParseLevel(level)